@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/analysisservices"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/applicationinsights"
@@ -86,6 +87,10 @@ type ArmClient struct {
 	environment              azure.Environment
 	skipProviderRegistration bool
 
+	// Features is the set of toggles controlling opt-in provider-level behaviour (e.g. destroy-time
+	// cleanup) configured via the `features` block on the Provider block.
+	Features features.UserFeatures
+
 	// Services
 	// NOTE: all new services should be Public as they're going to be relocated in the near-future
 	AnalysisServices *analysisservices.Client
@@ -150,7 +155,7 @@ type ArmClient struct {
 
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
-func getArmClient(authConfig *authentication.Config, skipProviderRegistration bool, tfVersion, partnerId string, disableCorrelationRequestID bool) (*ArmClient, error) {
+func getArmClient(authConfig *authentication.Config, skipProviderRegistration bool, tfVersion, partnerId string, disableCorrelationRequestID bool, maxRetries int, retryWaitSeconds int, storageUseAzureAD bool, userFeatures features.UserFeatures) (*ArmClient, error) {
 	env, err := authentication.DetermineEnvironment(authConfig.Environment)
 	if err != nil {
 		return nil, err
@@ -168,6 +173,7 @@ func getArmClient(authConfig *authentication.Config, skipProviderRegistration bo
 		usingServicePrincipal:    authConfig.AuthenticatedAsAServicePrincipal,
 		getAuthenticatedObjectID: authConfig.GetAuthenticatedObjectID,
 		skipProviderRegistration: skipProviderRegistration,
+		Features:                 userFeatures,
 	}
 
 	oauthConfig, err := authConfig.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
@@ -216,10 +222,13 @@ func getArmClient(authConfig *authentication.Config, skipProviderRegistration bo
 		ResourceManagerAuthorizer:   auth,
 		ResourceManagerEndpoint:     endpoint,
 		StorageAuthorizer:           storageAuth,
+		StorageUseAzureAD:           storageUseAzureAD,
 		PollingDuration:             180 * time.Minute,
 		SkipProviderReg:             skipProviderRegistration,
 		DisableCorrelationRequestID: disableCorrelationRequestID,
 		Environment:                 *env,
+		MaxRetries:                  maxRetries,
+		RetryWaitDuration:           time.Duration(retryWaitSeconds) * time.Second,
 	}
 
 	client.AnalysisServices = analysisservices.BuildClient(o)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/storage"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
 
@@ -44,6 +45,12 @@ func dataSourceArmStorageAccountBlobContainerSharedAccessSignature() *schema.Res
 				ValidateFunc: validate.SharedAccessSignatureIP,
 			},
 
+			"signed_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+
 			"start": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -134,6 +141,7 @@ func dataSourceArmStorageContainerSasRead(d *schema.ResourceData, _ interface{})
 	containerName := d.Get("container_name").(string)
 	httpsOnly := d.Get("https_only").(bool)
 	ip := d.Get("ip_address").(string)
+	signedIdentifier := d.Get("signed_identifier").(string)
 	start := d.Get("start").(string)
 	expiry := d.Get("expiry").(string)
 	permissionsIface := d.Get("permissions").([]interface{})
@@ -161,7 +169,6 @@ func dataSourceArmStorageContainerSasRead(d *schema.ResourceData, _ interface{})
 		signedProtocol = "https"
 	}
 	signedIp := ip
-	signedIdentifier := ""
 	signedSnapshotTime := ""
 
 	sasToken, err := storage.ComputeContainerSASToken(permissions, start, expiry, accountName, accountKey,
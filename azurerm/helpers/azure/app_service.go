@@ -503,6 +503,18 @@ func SchemaAppServiceLogsConfig() *schema.Schema {
 					MaxItems: 1,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
+							"file_system_level": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  string(web.Off),
+								ValidateFunc: validation.StringInSlice([]string{
+									string(web.Error),
+									string(web.Information),
+									string(web.Off),
+									string(web.Verbose),
+									string(web.Warning),
+								}, false),
+							},
 							"azure_blob_storage": {
 								Type:     schema.TypeList,
 								Optional: true,
@@ -584,6 +596,16 @@ func SchemaAppServiceLogsConfig() *schema.Schema {
 						},
 					},
 				},
+				"detailed_error_messages_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+				"failed_request_tracing_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
 			},
 		},
 	}
@@ -1166,6 +1188,10 @@ func FlattenAppServiceLogs(input *web.SiteLogsConfigProperties) []interface{} {
 	if input.ApplicationLogs != nil {
 		appLogsItem := make(map[string]interface{})
 
+		if fileSystemInput := input.ApplicationLogs.FileSystem; fileSystemInput != nil {
+			appLogsItem["file_system_level"] = string(fileSystemInput.Level)
+		}
+
 		blobStorage := make([]interface{}, 0)
 		if blobStorageInput := input.ApplicationLogs.AzureBlobStorage; blobStorageInput != nil {
 			blobStorageItem := make(map[string]interface{})
@@ -1236,6 +1262,14 @@ func FlattenAppServiceLogs(input *web.SiteLogsConfigProperties) []interface{} {
 	}
 	result["http_logs"] = httpLogs
 
+	if detailedErrorMessages := input.DetailedErrorMessages; detailedErrorMessages != nil && detailedErrorMessages.Enabled != nil {
+		result["detailed_error_messages_enabled"] = *detailedErrorMessages.Enabled
+	}
+
+	if failedRequestTracing := input.FailedRequestsTracing; failedRequestTracing != nil && failedRequestTracing.Enabled != nil {
+		result["failed_request_tracing_enabled"] = *failedRequestTracing.Enabled
+	}
+
 	return append(results, result)
 }
 
@@ -1257,6 +1291,12 @@ func ExpandAppServiceLogs(input interface{}) web.SiteLogsConfigProperties {
 
 			logs.ApplicationLogs = &web.ApplicationLogsConfig{}
 
+			if v, ok := appLogsConfig["file_system_level"]; ok {
+				logs.ApplicationLogs.FileSystem = &web.FileSystemApplicationLogsConfig{
+					Level: web.LogLevel(v.(string)),
+				}
+			}
+
 			if v, ok := appLogsConfig["azure_blob_storage"]; ok {
 				storageConfigs := v.([]interface{})
 
@@ -1311,6 +1351,18 @@ func ExpandAppServiceLogs(input interface{}) web.SiteLogsConfigProperties {
 		}
 	}
 
+	if v, ok := config["detailed_error_messages_enabled"]; ok {
+		logs.DetailedErrorMessages = &web.EnabledConfig{
+			Enabled: utils.Bool(v.(bool)),
+		}
+	}
+
+	if v, ok := config["failed_request_tracing_enabled"]; ok {
+		logs.FailedRequestsTracing = &web.EnabledConfig{
+			Enabled: utils.Bool(v.(bool)),
+		}
+	}
+
 	return logs
 }
 
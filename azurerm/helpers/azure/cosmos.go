@@ -134,6 +134,50 @@ func ParseCosmosKeyspaceID(id string) (*CosmosKeyspaceID, error) {
 	}, nil
 }
 
+type CosmosCassandraTableID struct {
+	CosmosKeyspaceID
+	Table string
+}
+
+func ParseCosmosCassandraTableID(id string) (*CosmosCassandraTableID, error) {
+	subid, err := ParseCosmosKeyspaceID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	table, ok := subid.Path["tables"]
+	if !ok {
+		return nil, fmt.Errorf("Error: Unable to parse Cosmos Cassandra Table Resource ID: tables is missing from: %s", id)
+	}
+
+	return &CosmosCassandraTableID{
+		CosmosKeyspaceID: *subid,
+		Table:            table,
+	}, nil
+}
+
+type CosmosGremlinGraphID struct {
+	CosmosDatabaseID
+	Graph string
+}
+
+func ParseCosmosGremlinGraphID(id string) (*CosmosGremlinGraphID, error) {
+	subid, err := ParseCosmosDatabaseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	graph, ok := subid.Path["graphs"]
+	if !ok {
+		return nil, fmt.Errorf("Error: Unable to parse Cosmos Gremlin Graph Resource ID: graphs is missing from: %s", id)
+	}
+
+	return &CosmosGremlinGraphID{
+		CosmosDatabaseID: *subid,
+		Graph:            graph,
+	}, nil
+}
+
 type CosmosTableID struct {
 	CosmosAccountID
 	Table string
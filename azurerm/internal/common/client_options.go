@@ -27,15 +27,27 @@ type ClientOptions struct {
 	ResourceManagerAuthorizer autorest.Authorizer
 	ResourceManagerEndpoint   string
 	StorageAuthorizer         autorest.Authorizer
+	StorageUseAzureAD         bool
 
 	SkipProviderReg             bool
 	DisableCorrelationRequestID bool
 	Environment                 azure.Environment
 
+	// MaxRetries is the number of times a request which fails with a throttling (429) or
+	// transient (408/5xx) status code will be retried before giving up. A value of 0 disables retries.
+	MaxRetries int
+	// RetryWaitDuration is the base exponential backoff interval between retries, used when the
+	// response doesn't include a `Retry-After` header.
+	RetryWaitDuration time.Duration
+
 	// TODO: remove me in 2.0
 	PollingDuration time.Duration
 }
 
+// retryMaxBackoff caps the exponential backoff applied between retries so that a large
+// MaxRetries/RetryWaitDuration combination can't block an apply for an unreasonable amount of time.
+const retryMaxBackoff = 5 * time.Minute
+
 func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.Authorizer) {
 	setUserAgent(c, o.TerraformVersion, o.PartnerId)
 
@@ -46,6 +58,10 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 		c.RequestInspector = WithCorrelationRequestID(CorrelationRequestID())
 	}
 
+	if o.MaxRetries > 0 {
+		c.Sender = autorest.DecorateSender(c.Sender, autorest.DoRetryForStatusCodesWithCap(o.MaxRetries, o.RetryWaitDuration, retryMaxBackoff, autorest.StatusCodesForRetry...))
+	}
+
 	// TODO: remove in 2.0
 	if !features.SupportsCustomTimeouts() {
 		c.PollingDuration = o.PollingDuration
@@ -0,0 +1,56 @@
+package features
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// UserFeaturesSchema returns the Schema used for the `features` block on the Provider block.
+func UserFeaturesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_vault": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"purge_soft_delete_on_destroy": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ExpandUserFeatures turns the `features` block on the Provider block into a UserFeatures struct,
+// defaulting every toggle as though an empty/absent `features` block had been supplied.
+func ExpandUserFeatures(input []interface{}) UserFeatures {
+	features := UserFeatures{
+		KeyVault: KeyVaultFeatures{
+			PurgeSoftDeleteOnDestroy: true,
+		},
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return features
+	}
+
+	val := input[0].(map[string]interface{})
+	if raw, ok := val["key_vault"].([]interface{}); ok && len(raw) > 0 && raw[0] != nil {
+		keyVaultRaw := raw[0].(map[string]interface{})
+		if v, ok := keyVaultRaw["purge_soft_delete_on_destroy"].(bool); ok {
+			features.KeyVault.PurgeSoftDeleteOnDestroy = v
+		}
+	}
+
+	return features
+}
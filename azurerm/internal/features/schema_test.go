@@ -0,0 +1,66 @@
+package features
+
+import (
+	"testing"
+)
+
+func TestExpandUserFeatures(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    []interface{}
+		expected UserFeatures
+	}{
+		{
+			name:  "empty block",
+			input: []interface{}{},
+			expected: UserFeatures{
+				KeyVault: KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+				},
+			},
+		},
+		{
+			name: "key vault purge disabled",
+			input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": false,
+						},
+					},
+				},
+			},
+			expected: UserFeatures{
+				KeyVault: KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy: false,
+				},
+			},
+		},
+		{
+			name: "key vault purge enabled",
+			input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": true,
+						},
+					},
+				},
+			},
+			expected: UserFeatures{
+				KeyVault: KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q..", v.name)
+
+		actual := ExpandUserFeatures(v.input)
+		if actual.KeyVault.PurgeSoftDeleteOnDestroy != v.expected.KeyVault.PurgeSoftDeleteOnDestroy {
+			t.Fatalf("Expected %t but got %t", v.expected.KeyVault.PurgeSoftDeleteOnDestroy, actual.KeyVault.PurgeSoftDeleteOnDestroy)
+		}
+	}
+}
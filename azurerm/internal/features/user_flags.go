@@ -0,0 +1,15 @@
+package features
+
+// UserFeatures holds the toggles for provider-level behaviour which is
+// opted into via the `features` block on the Provider block, rather than
+// being configured on a per-resource basis.
+type UserFeatures struct {
+	KeyVault KeyVaultFeatures
+}
+
+// KeyVaultFeatures controls the destroy-time behaviour of Key Vault resources.
+type KeyVaultFeatures struct {
+	// PurgeSoftDeleteOnDestroy controls whether a Key Vault (and any Secrets/Keys/Certificates within it)
+	// should be permanently purged once soft-deleted, rather than being left in a recoverable state.
+	PurgeSoftDeleteOnDestroy bool
+}
@@ -6,16 +6,18 @@ import (
 )
 
 type Client struct {
-	AccountClient               *automation.AccountClient
-	AgentRegistrationInfoClient *automation.AgentRegistrationInformationClient
-	CredentialClient            *automation.CredentialClient
-	DscConfigurationClient      *automation.DscConfigurationClient
-	DscNodeConfigurationClient  *automation.DscNodeConfigurationClient
-	ModuleClient                *automation.ModuleClient
-	RunbookClient               *automation.RunbookClient
-	RunbookDraftClient          *automation.RunbookDraftClient
-	ScheduleClient              *automation.ScheduleClient
-	VariableClient              *automation.VariableClient
+	AccountClient                  *automation.AccountClient
+	AgentRegistrationInfoClient    *automation.AgentRegistrationInformationClient
+	CredentialClient               *automation.CredentialClient
+	DscConfigurationClient         *automation.DscConfigurationClient
+	DscNodeConfigurationClient     *automation.DscNodeConfigurationClient
+	HybridRunbookWorkerGroupClient *automation.HybridRunbookWorkerGroupClient
+	JobScheduleClient              *automation.JobScheduleClient
+	ModuleClient                   *automation.ModuleClient
+	RunbookClient                  *automation.RunbookClient
+	RunbookDraftClient             *automation.RunbookDraftClient
+	ScheduleClient                 *automation.ScheduleClient
+	VariableClient                 *automation.VariableClient
 }
 
 func BuildClient(o *common.ClientOptions) *Client {
@@ -34,6 +36,12 @@ func BuildClient(o *common.ClientOptions) *Client {
 	DscNodeConfigurationClient := automation.NewDscNodeConfigurationClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&DscNodeConfigurationClient.Client, o.ResourceManagerAuthorizer)
 
+	HybridRunbookWorkerGroupClient := automation.NewHybridRunbookWorkerGroupClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&HybridRunbookWorkerGroupClient.Client, o.ResourceManagerAuthorizer)
+
+	JobScheduleClient := automation.NewJobScheduleClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&JobScheduleClient.Client, o.ResourceManagerAuthorizer)
+
 	ModuleClient := automation.NewModuleClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ModuleClient.Client, o.ResourceManagerAuthorizer)
 
@@ -50,15 +58,17 @@ func BuildClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&VariableClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AccountClient:               &AccountClient,
-		AgentRegistrationInfoClient: &AgentRegistrationInfoClient,
-		CredentialClient:            &CredentialClient,
-		DscConfigurationClient:      &DscConfigurationClient,
-		DscNodeConfigurationClient:  &DscNodeConfigurationClient,
-		ModuleClient:                &ModuleClient,
-		RunbookClient:               &RunbookClient,
-		RunbookDraftClient:          &RunbookDraftClient,
-		ScheduleClient:              &ScheduleClient,
-		VariableClient:              &VariableClient,
+		AccountClient:                  &AccountClient,
+		AgentRegistrationInfoClient:    &AgentRegistrationInfoClient,
+		CredentialClient:               &CredentialClient,
+		DscConfigurationClient:         &DscConfigurationClient,
+		DscNodeConfigurationClient:     &DscNodeConfigurationClient,
+		HybridRunbookWorkerGroupClient: &HybridRunbookWorkerGroupClient,
+		JobScheduleClient:              &JobScheduleClient,
+		ModuleClient:                   &ModuleClient,
+		RunbookClient:                  &RunbookClient,
+		RunbookDraftClient:             &RunbookDraftClient,
+		ScheduleClient:                 &ScheduleClient,
+		VariableClient:                 &VariableClient,
 	}
 }
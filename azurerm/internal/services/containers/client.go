@@ -9,6 +9,7 @@ import (
 
 type Client struct {
 	KubernetesClustersClient *containerservice.ManagedClustersClient
+	AgentPoolsClient         *containerservice.AgentPoolsClient
 	GroupsClient             *containerinstance.ContainerGroupsClient
 	RegistriesClient         *containerregistry.RegistriesClient
 	WebhooksClient           *containerregistry.WebhooksClient
@@ -37,8 +38,12 @@ func BuildClient(o *common.ClientOptions) *Client {
 	KubernetesClustersClient := containerservice.NewManagedClustersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&KubernetesClustersClient.Client, o.ResourceManagerAuthorizer)
 
+	AgentPoolsClient := containerservice.NewAgentPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&AgentPoolsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		KubernetesClustersClient: &KubernetesClustersClient,
+		AgentPoolsClient:         &AgentPoolsClient,
 		GroupsClient:             &GroupsClient,
 		RegistriesClient:         &RegistriesClient,
 		WebhooksClient:           &WebhooksClient,
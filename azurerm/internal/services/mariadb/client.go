@@ -6,11 +6,12 @@ import (
 )
 
 type Client struct {
-	ConfigurationsClient      *mariadb.ConfigurationsClient
-	DatabasesClient           *mariadb.DatabasesClient
-	FirewallRulesClient       *mariadb.FirewallRulesClient
-	ServersClient             *mariadb.ServersClient
-	VirtualNetworkRulesClient *mariadb.VirtualNetworkRulesClient
+	ConfigurationsClient              *mariadb.ConfigurationsClient
+	DatabasesClient                   *mariadb.DatabasesClient
+	FirewallRulesClient               *mariadb.FirewallRulesClient
+	ServersClient                     *mariadb.ServersClient
+	ServerSecurityAlertPoliciesClient *mariadb.ServerSecurityAlertPoliciesClient
+	VirtualNetworkRulesClient         *mariadb.VirtualNetworkRulesClient
 }
 
 func BuildClient(o *common.ClientOptions) *Client {
@@ -26,14 +27,18 @@ func BuildClient(o *common.ClientOptions) *Client {
 	ServersClient := mariadb.NewServersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ServersClient.Client, o.ResourceManagerAuthorizer)
 
+	ServerSecurityAlertPoliciesClient := mariadb.NewServerSecurityAlertPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ServerSecurityAlertPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
 	VirtualNetworkRulesClient := mariadb.NewVirtualNetworkRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VirtualNetworkRulesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ConfigurationsClient:      &configurationsClient,
-		DatabasesClient:           &DatabasesClient,
-		FirewallRulesClient:       &FirewallRulesClient,
-		ServersClient:             &ServersClient,
-		VirtualNetworkRulesClient: &VirtualNetworkRulesClient,
+		ConfigurationsClient:              &configurationsClient,
+		DatabasesClient:                   &DatabasesClient,
+		FirewallRulesClient:               &FirewallRulesClient,
+		ServersClient:                     &ServersClient,
+		ServerSecurityAlertPoliciesClient: &ServerSecurityAlertPoliciesClient,
+		VirtualNetworkRulesClient:         &VirtualNetworkRulesClient,
 	}
 }
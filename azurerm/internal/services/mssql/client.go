@@ -6,14 +6,24 @@ import (
 )
 
 type Client struct {
-	ElasticPoolsClient *sql.ElasticPoolsClient
+	DatabasesClient                        *sql.DatabasesClient
+	BackupShortTermRetentionPoliciesClient *sql.BackupShortTermRetentionPoliciesClient
+	ElasticPoolsClient                     *sql.ElasticPoolsClient
 }
 
 func BuildClient(o *common.ClientOptions) *Client {
+	DatabasesClient := sql.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&DatabasesClient.Client, o.ResourceManagerAuthorizer)
+
+	BackupShortTermRetentionPoliciesClient := sql.NewBackupShortTermRetentionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&BackupShortTermRetentionPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
 	ElasticPoolsClient := sql.NewElasticPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ElasticPoolsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ElasticPoolsClient: &ElasticPoolsClient,
+		DatabasesClient:                        &DatabasesClient,
+		BackupShortTermRetentionPoliciesClient: &BackupShortTermRetentionPoliciesClient,
+		ElasticPoolsClient:                     &ElasticPoolsClient,
 	}
 }
@@ -0,0 +1,31 @@
+package network
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type NatGatewayResourceID struct {
+	Base azure.ResourceID
+
+	Name string
+}
+
+func ParseNatGatewayResourceID(input string) (*NatGatewayResourceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Unable to parse NAT Gateway ID %q: %+v", input, err)
+	}
+
+	natGateway := NatGatewayResourceID{
+		Base: *id,
+		Name: id.Path["natGateways"],
+	}
+
+	if natGateway.Name == "" {
+		return nil, fmt.Errorf("ID was missing the `natGateways` element")
+	}
+
+	return &natGateway, nil
+}
@@ -17,6 +17,7 @@ type Client struct {
 	ContainerMappingClient          func(resourceGroupName string, vaultName string) siterecovery.ReplicationProtectionContainerMappingsClient
 	NetworkMappingClient            func(resourceGroupName string, vaultName string) siterecovery.ReplicationNetworkMappingsClient
 	ReplicationMigrationItemsClient func(resourceGroupName string, vaultName string) siterecovery.ReplicationProtectedItemsClient
+	RecoveryPlanClient              func(resourceGroupName string, vaultName string) siterecovery.ReplicationRecoveryPlansClient
 }
 
 func BuildClient(o *common.ClientOptions) *Client {
@@ -65,6 +66,12 @@ func BuildClient(o *common.ClientOptions) *Client {
 		return client
 	}
 
+	RecoveryPlanClient := func(resourceGroupName string, vaultName string) siterecovery.ReplicationRecoveryPlansClient {
+		client := siterecovery.NewReplicationRecoveryPlansClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId, resourceGroupName, vaultName)
+		o.ConfigureClient(&client.Client, o.ResourceManagerAuthorizer)
+		return client
+	}
+
 	return &Client{
 		ProtectedItemsClient:            &ProtectedItemsClient,
 		ProtectionPoliciesClient:        &ProtectionPoliciesClient,
@@ -75,5 +82,6 @@ func BuildClient(o *common.ClientOptions) *Client {
 		ContainerMappingClient:          ContainerMappingClient,
 		NetworkMappingClient:            NetworkMappingClient,
 		ReplicationMigrationItemsClient: ReplicationMigrationItemsClient,
+		RecoveryPlanClient:              RecoveryPlanClient,
 	}
 }
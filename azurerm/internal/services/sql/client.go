@@ -7,12 +7,15 @@ import (
 
 type Client struct {
 	DatabasesClient                       *sql.DatabasesClient
+	DatabaseBlobAuditingPoliciesClient    *sql.DatabaseBlobAuditingPoliciesClient
 	DatabaseThreatDetectionPoliciesClient *sql.DatabaseThreatDetectionPoliciesClient
 	ElasticPoolsClient                    *sql.ElasticPoolsClient
+	EncryptionProtectorsClient            *sql.EncryptionProtectorsClient
 	FirewallRulesClient                   *sql.FirewallRulesClient
 	FailoverGroupsClient                  *sql.FailoverGroupsClient
 	ServersClient                         *sql.ServersClient
 	ServerAzureADAdministratorsClient     *sql.ServerAzureADAdministratorsClient
+	ServerKeysClient                      *sql.ServerKeysClient
 	VirtualNetworkRulesClient             *sql.VirtualNetworkRulesClient
 }
 
@@ -21,12 +24,18 @@ func BuildClient(o *common.ClientOptions) *Client {
 	DatabasesClient := sql.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&DatabasesClient.Client, o.ResourceManagerAuthorizer)
 
+	DatabaseBlobAuditingPoliciesClient := sql.NewDatabaseBlobAuditingPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&DatabaseBlobAuditingPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
 	DatabaseThreatDetectionPoliciesClient := sql.NewDatabaseThreatDetectionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&DatabaseThreatDetectionPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
 	ElasticPoolsClient := sql.NewElasticPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ElasticPoolsClient.Client, o.ResourceManagerAuthorizer)
 
+	EncryptionProtectorsClient := sql.NewEncryptionProtectorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&EncryptionProtectorsClient.Client, o.ResourceManagerAuthorizer)
+
 	FailoverGroupsClient := sql.NewFailoverGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&FailoverGroupsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -39,17 +48,23 @@ func BuildClient(o *common.ClientOptions) *Client {
 	ServerAzureADAdministratorsClient := sql.NewServerAzureADAdministratorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ServerAzureADAdministratorsClient.Client, o.ResourceManagerAuthorizer)
 
+	ServerKeysClient := sql.NewServerKeysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ServerKeysClient.Client, o.ResourceManagerAuthorizer)
+
 	VirtualNetworkRulesClient := sql.NewVirtualNetworkRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VirtualNetworkRulesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
 		DatabasesClient:                       &DatabasesClient,
+		DatabaseBlobAuditingPoliciesClient:    &DatabaseBlobAuditingPoliciesClient,
 		DatabaseThreatDetectionPoliciesClient: &DatabaseThreatDetectionPoliciesClient,
 		ElasticPoolsClient:                    &ElasticPoolsClient,
+		EncryptionProtectorsClient:            &EncryptionProtectorsClient,
 		FailoverGroupsClient:                  &FailoverGroupsClient,
 		FirewallRulesClient:                   &FirewallRulesClient,
 		ServersClient:                         &ServersClient,
 		ServerAzureADAdministratorsClient:     &ServerAzureADAdministratorsClient,
+		ServerKeysClient:                      &ServerKeysClient,
 		VirtualNetworkRulesClient:             &VirtualNetworkRulesClient,
 	}
 }
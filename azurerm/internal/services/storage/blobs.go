@@ -3,6 +3,8 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -130,6 +132,15 @@ func (sbu BlobUpload) uploadBlockBlobFromContent(ctx context.Context) error {
 	return sbu.uploadBlockBlob(ctx)
 }
 
+// maxSinglePutBlockBlobSize is the largest file this provider will upload in a single PutBlockBlob
+// call - anything larger is split into blockBlobChunkSize chunks and uploaded with the
+// PutBlock/PutBlockList worker pool below, rather than read into memory and sent in one request.
+const maxSinglePutBlockBlobSize int64 = 256 * 1024 * 1024
+
+const blockBlobChunkSize int64 = 4 * 1024 * 1024
+
+const blockUploadRetryAttempts = 3
+
 func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	file, err := os.Open(sbu.Source)
 	if err != nil {
@@ -137,17 +148,141 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	}
 	defer file.Close()
 
-	input := blobs.PutBlockBlobInput{
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
+	}
+
+	contentMD5, err := computeBlockBlobContentMD5(file)
+	if err != nil {
+		return fmt.Errorf("Error computing Content-MD5 for %q: %s", sbu.Source, err)
+	}
+
+	if info.Size() <= maxSinglePutBlockBlobSize {
+		input := blobs.PutBlockBlobInput{
+			ContentType: utils.String(sbu.ContentType),
+			ContentMD5:  utils.String(contentMD5),
+			MetaData:    sbu.MetaData,
+		}
+		if err := sbu.Client.PutBlockBlobFromFile(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, file, input); err != nil {
+			return fmt.Errorf("Error PutBlockBlobFromFile: %s", err)
+		}
+
+		return nil
+	}
+
+	return sbu.uploadBlockBlobInChunks(ctx, file, info.Size(), contentMD5)
+}
+
+func computeBlockBlobContentMD5(file *os.File) (string, error) {
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("Error hashing file: %s", err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", fmt.Errorf("Error resetting file offset: %s", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+type storageBlobBlock struct {
+	id      string
+	section *io.SectionReader
+}
+
+func (sbu BlobUpload) uploadBlockBlobInChunks(ctx context.Context, file *os.File, fileSize int64, contentMD5 string) error {
+	workerCount := sbu.Parallelism * runtime.NumCPU()
+
+	var blockIDs []blobs.BlockID
+	var blocks []storageBlobBlock
+	for offset := int64(0); offset < fileSize; offset += blockBlobChunkSize {
+		length := blockBlobChunkSize
+		if remaining := fileSize - offset; remaining < length {
+			length = remaining
+		}
+
+		blockID := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", len(blockIDs))))
+		blockIDs = append(blockIDs, blobs.BlockID{Value: blockID})
+		blocks = append(blocks, storageBlobBlock{
+			id:      blockID,
+			section: io.NewSectionReader(file, offset, length),
+		})
+	}
+
+	blockChan := make(chan storageBlobBlock, len(blocks))
+	errors := make(chan error, len(blocks))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(blocks))
+
+	for _, block := range blocks {
+		blockChan <- block
+	}
+	close(blockChan)
+
+	for i := 0; i < workerCount; i++ {
+		go sbu.blobBlockUploadWorker(ctx, blockUploadContext{
+			blocks: blockChan,
+			errors: errors,
+			wg:     wg,
+		})
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return fmt.Errorf("Error while uploading source file %q: %s", sbu.Source, <-errors)
+	}
+
+	input := blobs.PutBlockListInput{
+		BlockList:   blobs.BlockList{LatestBlockIDs: blockIDs},
 		ContentType: utils.String(sbu.ContentType),
+		ContentMD5:  utils.String(contentMD5),
 		MetaData:    sbu.MetaData,
 	}
-	if err := sbu.Client.PutBlockBlobFromFile(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, file, input); err != nil {
-		return fmt.Errorf("Error PutBlockBlobFromFile: %s", err)
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("Error PutBlockList: %s", err)
 	}
 
 	return nil
 }
 
+type blockUploadContext struct {
+	blocks chan storageBlobBlock
+	errors chan error
+	wg     *sync.WaitGroup
+}
+
+func (sbu BlobUpload) blobBlockUploadWorker(ctx context.Context, uploadCtx blockUploadContext) {
+	for block := range uploadCtx.blocks {
+		chunk := make([]byte, block.section.Size())
+		if _, err := block.section.Read(chunk); err != nil && err != io.EOF {
+			uploadCtx.errors <- fmt.Errorf("Error reading source file %q: %s", sbu.Source, err)
+			uploadCtx.wg.Done()
+			continue
+		}
+
+		input := blobs.PutBlockInput{
+			BlockID: block.id,
+			Content: chunk,
+		}
+
+		var err error
+		for attempt := 1; attempt <= blockUploadRetryAttempts; attempt++ {
+			if _, err = sbu.Client.PutBlock(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			uploadCtx.errors <- fmt.Errorf("Error writing block %q for file %q: %s", block.id, sbu.Source, err)
+			uploadCtx.wg.Done()
+			continue
+		}
+
+		uploadCtx.wg.Done()
+	}
+}
+
 func (sbu BlobUpload) createEmptyPageBlob(ctx context.Context) error {
 	if sbu.Size == 0 {
 		return fmt.Errorf("`size` cannot be zero for a page blob")
@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+)
+
+func TestComputeBlockBlobContentMD5(t *testing.T) {
+	file, err := ioutil.TempFile("", "blobs-md5-test")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %s", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	contents := make([]byte, 1*1024*1024)
+	if _, err := rand.Read(contents); err != nil {
+		t.Fatalf("Failed to generate random contents: %s", err)
+	}
+	if _, err := file.Write(contents); err != nil {
+		t.Fatalf("Failed to write contents to temp file: %s", err)
+	}
+	if _, err := file.Seek(0, os.SEEK_SET); err != nil {
+		t.Fatalf("Failed to reset file offset: %s", err)
+	}
+
+	expectedHash := md5.Sum(contents)
+	expected := base64.StdEncoding.EncodeToString(expectedHash[:])
+
+	actual, err := computeBlockBlobContentMD5(file)
+	if err != nil {
+		t.Fatalf("Failed to compute Content-MD5: %s", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected Content-MD5 %q but got %q", expected, actual)
+	}
+
+	// the file offset should be reset so the caller can re-read the contents for upload
+	offset, err := file.Seek(0, os.SEEK_CUR)
+	if err != nil {
+		t.Fatalf("Failed to determine file offset: %s", err)
+	}
+	if offset != 0 {
+		t.Fatalf("Expected file offset to be reset to 0 but got %d", offset)
+	}
+}
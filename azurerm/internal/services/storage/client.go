@@ -7,6 +7,7 @@ import (
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/datalakestore/filesystems"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/Azure/go-autorest/autorest"
 	az "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/authorizers"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
@@ -21,16 +22,23 @@ import (
 
 type Client struct {
 	AccountsClient           *storage.AccountsClient
+	BlobServicesClient       *storage.BlobServicesClient
 	FileSystemsClient        *filesystems.Client
 	ManagementPoliciesClient storage.ManagementPoliciesClient
 
 	environment az.Environment
+
+	storageAdAuth     autorest.Authorizer
+	storageUseAzureAD bool
 }
 
 func BuildClient(options *common.ClientOptions) *Client {
 	accountsClient := storage.NewAccountsClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&accountsClient.Client, options.ResourceManagerAuthorizer)
 
+	blobServicesClient := storage.NewBlobServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&blobServicesClient.Client, options.ResourceManagerAuthorizer)
+
 	fileSystemsClient := filesystems.NewWithEnvironment(options.Environment)
 	fileSystemsClient.Authorizer = options.StorageAuthorizer
 
@@ -41,33 +49,46 @@ func BuildClient(options *common.ClientOptions) *Client {
 	// (which should fix #2977) when the storage clients have been moved in here
 	return &Client{
 		AccountsClient:           &accountsClient,
+		BlobServicesClient:       &blobServicesClient,
 		FileSystemsClient:        &fileSystemsClient,
 		ManagementPoliciesClient: managementPoliciesClient,
 		environment:              options.Environment,
+		storageAdAuth:            options.StorageAuthorizer,
+		storageUseAzureAD:        options.StorageUseAzureAD,
 	}
 }
 
 func (client Client) BlobsClient(ctx context.Context, resourceGroup, accountName string) (*blobs.Client, error) {
+	blobsClient := blobs.NewWithEnvironment(client.environment)
+
+	if client.storageUseAzureAD {
+		blobsClient.Client.Authorizer = client.storageAdAuth
+		return &blobsClient, nil
+	}
+
 	accountKey, err := client.findAccountKey(ctx, resourceGroup, accountName)
 	if err != nil {
 		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
 	}
 
-	storageAuth := authorizers.NewSharedKeyAuthorizer(accountName, *accountKey)
-	blobsClient := blobs.NewWithEnvironment(client.environment)
-	blobsClient.Client.Authorizer = storageAuth
+	blobsClient.Client.Authorizer = authorizers.NewSharedKeyAuthorizer(accountName, *accountKey)
 	return &blobsClient, nil
 }
 
 func (client Client) ContainersClient(ctx context.Context, resourceGroup, accountName string) (*containers.Client, error) {
+	containersClient := containers.NewWithEnvironment(client.environment)
+
+	if client.storageUseAzureAD {
+		containersClient.Client.Authorizer = client.storageAdAuth
+		return &containersClient, nil
+	}
+
 	accountKey, err := client.findAccountKey(ctx, resourceGroup, accountName)
 	if err != nil {
 		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
 	}
 
-	storageAuth := authorizers.NewSharedKeyAuthorizer(accountName, *accountKey)
-	containersClient := containers.NewWithEnvironment(client.environment)
-	containersClient.Client.Authorizer = storageAuth
+	containersClient.Client.Authorizer = authorizers.NewSharedKeyAuthorizer(accountName, *accountKey)
 	return &containersClient, nil
 }
 
@@ -96,37 +117,52 @@ func (client Client) FileSharesClient(ctx context.Context, resourceGroup, accoun
 }
 
 func (client Client) QueuesClient(ctx context.Context, resourceGroup, accountName string) (*queues.Client, error) {
+	queuesClient := queues.NewWithEnvironment(client.environment)
+
+	if client.storageUseAzureAD {
+		queuesClient.Client.Authorizer = client.storageAdAuth
+		return &queuesClient, nil
+	}
+
 	accountKey, err := client.findAccountKey(ctx, resourceGroup, accountName)
 	if err != nil {
 		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
 	}
 
-	storageAuth := authorizers.NewSharedKeyLiteAuthorizer(accountName, *accountKey)
-	queuesClient := queues.NewWithEnvironment(client.environment)
-	queuesClient.Client.Authorizer = storageAuth
+	queuesClient.Client.Authorizer = authorizers.NewSharedKeyLiteAuthorizer(accountName, *accountKey)
 	return &queuesClient, nil
 }
 
 func (client Client) TableEntityClient(ctx context.Context, resourceGroup, accountName string) (*entities.Client, error) {
+	entitiesClient := entities.NewWithEnvironment(client.environment)
+
+	if client.storageUseAzureAD {
+		entitiesClient.Client.Authorizer = client.storageAdAuth
+		return &entitiesClient, nil
+	}
+
 	accountKey, err := client.findAccountKey(ctx, resourceGroup, accountName)
 	if err != nil {
 		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
 	}
 
-	storageAuth := authorizers.NewSharedKeyLiteTableAuthorizer(accountName, *accountKey)
-	entitiesClient := entities.NewWithEnvironment(client.environment)
-	entitiesClient.Client.Authorizer = storageAuth
+	entitiesClient.Client.Authorizer = authorizers.NewSharedKeyLiteTableAuthorizer(accountName, *accountKey)
 	return &entitiesClient, nil
 }
 
 func (client Client) TablesClient(ctx context.Context, resourceGroup, accountName string) (*tables.Client, error) {
+	tablesClient := tables.NewWithEnvironment(client.environment)
+
+	if client.storageUseAzureAD {
+		tablesClient.Client.Authorizer = client.storageAdAuth
+		return &tablesClient, nil
+	}
+
 	accountKey, err := client.findAccountKey(ctx, resourceGroup, accountName)
 	if err != nil {
 		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
 	}
 
-	storageAuth := authorizers.NewSharedKeyLiteTableAuthorizer(accountName, *accountKey)
-	tablesClient := tables.NewWithEnvironment(client.environment)
-	tablesClient.Client.Authorizer = storageAuth
+	tablesClient.Client.Authorizer = authorizers.NewSharedKeyLiteTableAuthorizer(accountName, *accountKey)
 	return &tablesClient, nil
 }
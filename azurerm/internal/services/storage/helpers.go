@@ -32,12 +32,22 @@ func (client Client) ClearFromCache(resourceGroup, accountName string) {
 
 func (client Client) FindResourceGroup(ctx context.Context, accountName string) (*string, error) {
 	cacheKey := accountName
-	if v, ok := resourceGroupNamesCache[cacheKey]; ok {
+	writeLock.RLock()
+	v, ok := resourceGroupNamesCache[cacheKey]
+	writeLock.RUnlock()
+	if ok {
 		return &v, nil
 	}
 
 	log.Printf("[DEBUG] Cache Miss - looking up the resource group for storage account %q..", accountName)
 	writeLock.Lock()
+	defer writeLock.Unlock()
+
+	// another goroutine may have populated the cache while we were waiting for the write lock
+	if v, ok := resourceGroupNamesCache[cacheKey]; ok {
+		return &v, nil
+	}
+
 	accounts, err := client.AccountsClient.List(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("Error listing Storage Accounts (to find Resource Group for %q): %s", accountName, err)
@@ -68,18 +78,26 @@ func (client Client) FindResourceGroup(ctx context.Context, accountName string)
 		resourceGroupNamesCache[cacheKey] = *resourceGroup
 	}
 
-	writeLock.Unlock()
-
 	return resourceGroup, nil
 }
 
 func (client Client) findAccountKey(ctx context.Context, resourceGroup, accountName string) (*string, error) {
 	cacheKey := fmt.Sprintf("%s-%s", resourceGroup, accountName)
-	if v, ok := accountKeysCache[cacheKey]; ok {
+	writeLock.RLock()
+	v, ok := accountKeysCache[cacheKey]
+	writeLock.RUnlock()
+	if ok {
 		return &v, nil
 	}
 
 	writeLock.Lock()
+	defer writeLock.Unlock()
+
+	// another goroutine may have populated the cache while we were waiting for the write lock
+	if v, ok := accountKeysCache[cacheKey]; ok {
+		return &v, nil
+	}
+
 	log.Printf("[DEBUG] Cache Miss - looking up the account key for storage account %q..", accountName)
 	props, err := client.AccountsClient.ListKeys(ctx, resourceGroup, accountName)
 	if err != nil {
@@ -94,7 +112,6 @@ func (client Client) findAccountKey(ctx context.Context, resourceGroup, accountN
 	firstKey := keys[0].Value
 
 	accountKeysCache[cacheKey] = *firstKey
-	writeLock.Unlock()
 
 	return firstKey, nil
 }
@@ -172,10 +172,15 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_api_management_user":                                resourceArmApiManagementUser(),
 		"azurerm_app_service_active_slot":                            resourceArmAppServiceActiveSlot(),
 		"azurerm_app_service_certificate":                            resourceArmAppServiceCertificate(),
+		"azurerm_app_service_certificate_order":                      resourceArmAppServiceCertificateOrder(),
 		"azurerm_app_service_custom_hostname_binding":                resourceArmAppServiceCustomHostnameBinding(),
 		"azurerm_app_service_plan":                                   resourceArmAppServicePlan(),
+		"azurerm_app_service_slot_config_names":                      resourceArmAppServiceSlotConfigNames(),
 		"azurerm_app_service_slot":                                   resourceArmAppServiceSlot(),
+		"azurerm_app_service_slot_custom_hostname_binding":           resourceArmAppServiceSlotCustomHostnameBinding(),
+		"azurerm_app_service_source_control":                         resourceArmAppServiceSourceControl(),
 		"azurerm_app_service_source_control_token":                   resourceArmAppServiceSourceControlToken(),
+		"azurerm_app_service_virtual_network_swift_connection":       resourceArmAppServiceVirtualNetworkSwiftConnection(),
 		"azurerm_app_service":                                        resourceArmAppService(),
 		"azurerm_application_gateway":                                resourceArmApplicationGateway(),
 		"azurerm_application_insights_api_key":                       resourceArmApplicationInsightsAPIKey(),
@@ -187,6 +192,8 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_automation_credential":                              resourceArmAutomationCredential(),
 		"azurerm_automation_dsc_configuration":                       resourceArmAutomationDscConfiguration(),
 		"azurerm_automation_dsc_nodeconfiguration":                   resourceArmAutomationDscNodeConfiguration(),
+		"azurerm_automation_hybrid_runbook_worker_group":             resourceArmAutomationHybridRunbookWorkerGroup(),
+		"azurerm_automation_job_schedule":                            resourceArmAutomationJobSchedule(),
 		"azurerm_automation_module":                                  resourceArmAutomationModule(),
 		"azurerm_automation_runbook":                                 resourceArmAutomationRunbook(),
 		"azurerm_automation_schedule":                                resourceArmAutomationSchedule(),
@@ -219,6 +226,9 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_container_service":                                  resourceArmContainerService(),
 		"azurerm_cosmosdb_account":                                   resourceArmCosmosDbAccount(),
 		"azurerm_cosmosdb_cassandra_keyspace":                        resourceArmCosmosDbCassandraKeyspace(),
+		"azurerm_cosmosdb_cassandra_table":                           resourceArmCosmosDbCassandraTable(),
+		"azurerm_cosmosdb_gremlin_database":                          resourceArmCosmosDbGremlinDatabase(),
+		"azurerm_cosmosdb_gremlin_graph":                             resourceArmCosmosDbGremlinGraph(),
 		"azurerm_cosmosdb_mongo_collection":                          resourceArmCosmosDbMongoCollection(),
 		"azurerm_cosmosdb_mongo_database":                            resourceArmCosmosDbMongoDatabase(),
 		"azurerm_cosmosdb_sql_container":                             resourceArmCosmosDbSQLContainer(),
@@ -233,6 +243,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_data_factory_linked_service_mysql":                  resourceArmDataFactoryLinkedServiceMySQL(),
 		"azurerm_data_factory_linked_service_postgresql":             resourceArmDataFactoryLinkedServicePostgreSQL(),
 		"azurerm_data_factory_linked_service_sql_server":             resourceArmDataFactoryLinkedServiceSQLServer(),
+		"azurerm_data_factory_integration_runtime_managed":           resourceArmDataFactoryIntegrationRuntimeManaged(),
 		"azurerm_data_factory_pipeline":                              resourceArmDataFactoryPipeline(),
 		"azurerm_data_lake_analytics_account":                        resourceArmDataLakeAnalyticsAccount(),
 		"azurerm_data_lake_analytics_firewall_rule":                  resourceArmDataLakeAnalyticsFirewallRule(),
@@ -268,8 +279,11 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_eventhub_namespace":                                 resourceArmEventHubNamespace(),
 		"azurerm_eventhub":                                           resourceArmEventHub(),
 		"azurerm_express_route_circuit_authorization":                resourceArmExpressRouteCircuitAuthorization(),
+		"azurerm_express_route_circuit_connection":                   resourceArmExpressRouteCircuitConnection(),
 		"azurerm_express_route_circuit_peering":                      resourceArmExpressRouteCircuitPeering(),
 		"azurerm_express_route_circuit":                              resourceArmExpressRouteCircuit(),
+		"azurerm_express_route_connection":                           resourceArmExpressRouteConnection(),
+		"azurerm_express_route_gateway":                              resourceArmExpressRouteGateway(),
 		"azurerm_firewall_application_rule_collection":               resourceArmFirewallApplicationRuleCollection(),
 		"azurerm_firewall_nat_rule_collection":                       resourceArmFirewallNatRuleCollection(),
 		"azurerm_firewall_network_rule_collection":                   resourceArmFirewallNetworkRuleCollection(),
@@ -320,6 +334,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_logic_app_workflow":                                 resourceArmLogicAppWorkflow(),
 		"azurerm_managed_disk":                                       resourceArmManagedDisk(),
 		"azurerm_management_group":                                   resourceArmManagementGroup(),
+		"azurerm_management_group_subscription_association":          resourceArmManagementGroupSubscriptionAssociation(),
 		"azurerm_management_lock":                                    resourceArmManagementLock(),
 		"azurerm_maps_account":                                       resourceArmMapsAccount(),
 		"azurerm_mariadb_configuration":                              resourceArmMariaDbConfiguration(),
@@ -337,6 +352,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_monitor_log_profile":                                resourceArmMonitorLogProfile(),
 		"azurerm_monitor_metric_alert":                               resourceArmMonitorMetricAlert(),
 		"azurerm_monitor_metric_alertrule":                           resourceArmMonitorMetricAlertRule(),
+		"azurerm_mssql_database":                                     resourceArmMsSqlDatabase(),
 		"azurerm_mssql_elasticpool":                                  resourceArmMsSqlElasticPool(),
 		"azurerm_mysql_configuration":                                resourceArmMySQLConfiguration(),
 		"azurerm_mysql_database":                                     resourceArmMySqlDatabase(),
@@ -351,6 +367,9 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_network_interface_backend_address_pool_association":                     resourceArmNetworkInterfaceBackendAddressPoolAssociation(),
 		"azurerm_network_interface_nat_rule_association":                                 resourceArmNetworkInterfaceNatRuleAssociation(),
 		"azurerm_network_packet_capture":                                                 resourceArmNetworkPacketCapture(),
+		"azurerm_nat_gateway":                                                            resourceArmNatGateway(),
+		"azurerm_nat_gateway_public_ip_association":                                      resourceArmNatGatewayPublicIpAssociation(),
+		"azurerm_nat_gateway_public_ip_prefix_association":                               resourceArmNatGatewayPublicIpPrefixAssociation(),
 		"azurerm_network_profile":                                                        resourceArmNetworkProfile(),
 		"azurerm_network_security_group":                                                 resourceArmNetworkSecurityGroup(),
 		"azurerm_network_security_rule":                                                  resourceArmNetworkSecurityRule(),
@@ -380,13 +399,16 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_recovery_services_protected_vm":                                         resourceArmRecoveryServicesProtectedVm(),
 		"azurerm_recovery_services_protection_container":                                 resourceArmRecoveryServicesProtectionContainer(),
 		"azurerm_recovery_services_protection_container_mapping":                         resourceArmRecoveryServicesProtectionContainerMapping(),
+		"azurerm_recovery_services_protection_policy_file_share":                         resourceArmRecoveryServicesProtectionPolicyFileShare(),
 		"azurerm_recovery_services_protection_policy_vm":                                 resourceArmRecoveryServicesProtectionPolicyVm(),
 		"azurerm_recovery_services_replication_policy":                                   resourceArmRecoveryServicesReplicationPolicy(),
+		"azurerm_recovery_services_replication_recovery_plan":                            resourceArmRecoveryServicesReplicationRecoveryPlan(),
 		"azurerm_recovery_services_vault":                                                resourceArmRecoveryServicesVault(),
 		"azurerm_redis_cache":                                                            resourceArmRedisCache(),
 		"azurerm_redis_firewall_rule":                                                    resourceArmRedisFirewallRule(),
 		"azurerm_relay_namespace":                                                        resourceArmRelayNamespace(),
 		"azurerm_resource_group":                                                         resourceArmResourceGroup(),
+		"azurerm_resource_group_template_deployment":                                     resourceArmResourceGroupTemplateDeployment(),
 		"azurerm_role_assignment":                                                        resourceArmRoleAssignment(),
 		"azurerm_role_definition":                                                        resourceArmRoleDefinition(),
 		"azurerm_route_table":                                                            resourceArmRouteTable(),
@@ -417,8 +439,13 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_sql_failover_group":                                                     resourceArmSqlFailoverGroup(),
 		"azurerm_sql_firewall_rule":                                                      resourceArmSqlFirewallRule(),
 		"azurerm_sql_server":                                                             resourceArmSqlServer(),
+		"azurerm_sql_server_key":                                                         resourceArmSqlServerKey(),
+		"azurerm_sql_server_transparent_data_encryption":                                 resourceArmSqlServerTransparentDataEncryption(),
 		"azurerm_sql_virtual_network_rule":                                               resourceArmSqlVirtualNetworkRule(),
 		"azurerm_storage_account":                                                        resourceArmStorageAccount(),
+		"azurerm_storage_account_customer_managed_key":                                   resourceArmStorageAccountCustomerManagedKey(),
+		"azurerm_storage_account_failover":                                               resourceArmStorageAccountFailover(),
+		"azurerm_storage_account_network_rules":                                          resourceArmStorageAccountNetworkRules(),
 		"azurerm_storage_blob":                                                           resourceArmStorageBlob(),
 		"azurerm_storage_container":                                                      resourceArmStorageContainer(),
 		"azurerm_storage_data_lake_gen2_filesystem":                                      resourceArmStorageDataLakeGen2FileSystem(),
@@ -438,9 +465,11 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_stream_analytics_stream_input_blob":                                     resourceArmStreamAnalyticsStreamInputBlob(),
 		"azurerm_stream_analytics_stream_input_eventhub":                                 resourceArmStreamAnalyticsStreamInputEventHub(),
 		"azurerm_stream_analytics_stream_input_iothub":                                   resourceArmStreamAnalyticsStreamInputIoTHub(),
+		"azurerm_subnet_nat_gateway_association":                                         resourceArmSubnetNatGatewayAssociation(),
 		"azurerm_subnet_network_security_group_association":                              resourceArmSubnetNetworkSecurityGroupAssociation(),
 		"azurerm_subnet_route_table_association":                                         resourceArmSubnetRouteTableAssociation(),
 		"azurerm_subnet":                                                                 resourceArmSubnet(),
+		"azurerm_subscription_template_deployment":                                       resourceArmSubscriptionTemplateDeployment(),
 		"azurerm_template_deployment":                                                    resourceArmTemplateDeployment(),
 		"azurerm_traffic_manager_endpoint":                                               resourceArmTrafficManagerEndpoint(),
 		"azurerm_traffic_manager_profile":                                                resourceArmTrafficManagerProfile(),
@@ -449,6 +478,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_virtual_machine_extension":                                              resourceArmVirtualMachineExtensions(),
 		"azurerm_virtual_machine_scale_set":                                              resourceArmVirtualMachineScaleSet(),
 		"azurerm_virtual_machine":                                                        resourceArmVirtualMachine(),
+		"azurerm_virtual_network_dns_servers":                                            resourceArmVirtualNetworkDnsServers(),
 		"azurerm_virtual_network_gateway_connection":                                     resourceArmVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network_gateway":                                                resourceArmVirtualNetworkGateway(),
 		"azurerm_virtual_network_peering":                                                resourceArmVirtualNetworkPeering(),
@@ -619,6 +649,29 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 				Description: "Should the AzureRM Provider skip registering all of the Resource Providers that it supports, if they're not already registered?",
 			},
+
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_MAX_RETRIES", 3),
+				Description: "The maximum number of times a request to the Azure Resource Manager API that fails with a throttling (429) or transient (408/5xx) status code will be retried before returning an error. Set to 0 to disable retries.",
+			},
+
+			"retry_wait_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_RETRY_WAIT_SECONDS", 10),
+				Description: "The base number of seconds to exponentially back off between retries. Ignored for responses which include a `Retry-After` header, which is honored instead.",
+			},
+
+			"storage_use_azuread": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
+				Description: "Should the AzureRM Provider use Azure AD to access the Storage Data Plane API's (Blobs, Containers, Queues, Tables) instead of retrieving the Storage Account's access key?",
+			},
+
+			"features": features.UserFeaturesSchema(),
 		},
 
 		DataSourcesMap: dataSources,
@@ -675,6 +728,10 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 		partnerId := d.Get("partner_id").(string)
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
 		disableCorrelationRequestID := d.Get("disable_correlation_request_id").(bool)
+		maxRetries := d.Get("max_retries").(int)
+		retryWaitSeconds := d.Get("retry_wait_seconds").(int)
+		storageUseAzureAD := d.Get("storage_use_azuread").(bool)
+		userFeatures := features.ExpandUserFeatures(d.Get("features").([]interface{}))
 
 		terraformVersion := p.TerraformVersion
 		if terraformVersion == "" {
@@ -683,7 +740,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			terraformVersion = "0.11+compatible"
 		}
 
-		client, err := getArmClient(config, skipProviderRegistration, terraformVersion, partnerId, disableCorrelationRequestID)
+		client, err := getArmClient(config, skipProviderRegistration, terraformVersion, partnerId, disableCorrelationRequestID, maxRetries, retryWaitSeconds, storageUseAzureAD, userFeatures)
 		if err != nil {
 			return nil, err
 		}
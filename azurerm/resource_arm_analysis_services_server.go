@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -104,6 +105,19 @@ func resourceArmAnalysisServicesServer() *schema.Resource {
 				ValidateFunc: validate.NoEmptyStrings,
 			},
 
+			"scale_out_query_replica_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"paused": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"server_full_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -147,7 +161,7 @@ func resourceArmAnalysisServicesServerCreate(d *schema.ResourceData, meta interf
 	analysisServicesServer := analysisservices.Server{
 		Name:             &name,
 		Location:         &location,
-		Sku:              &analysisservices.ResourceSku{Name: &sku},
+		Sku:              expandAnalysisServicesServerSku(d, sku),
 		ServerProperties: serverProperties,
 		Tags:             tags.Expand(t),
 	}
@@ -172,6 +186,12 @@ func resourceArmAnalysisServicesServerCreate(d *schema.ResourceData, meta interf
 
 	d.SetId(*resp.ID)
 
+	if d.Get("paused").(bool) {
+		if err := resourceArmAnalysisServicesServerSetPowerState(ctx, client, resourceGroup, name, true); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmAnalysisServicesServerRead(d, meta)
 }
 
@@ -207,9 +227,17 @@ func resourceArmAnalysisServicesServerRead(d *schema.ResourceData, meta interfac
 
 	if server.Sku != nil {
 		d.Set("sku", server.Sku.Name)
+
+		scaleOutQueryReplicaCount := 0
+		if server.Sku.Capacity != nil {
+			scaleOutQueryReplicaCount = int(*server.Sku.Capacity)
+		}
+		d.Set("scale_out_query_replica_count", scaleOutQueryReplicaCount)
 	}
 
 	if serverProps := server.ServerProperties; serverProps != nil {
+		d.Set("paused", serverProps.State == analysisservices.StatePaused)
+
 		if serverProps.AsAdministrators == nil {
 			d.Set("admin_users", []string{})
 		} else {
@@ -249,12 +277,18 @@ func resourceArmAnalysisServicesServerUpdate(d *schema.ResourceData, meta interf
 	resourceGroup := id.ResourceGroup
 	name := id.Path["servers"]
 
+	if d.HasChange("paused") {
+		if err := resourceArmAnalysisServicesServerSetPowerState(ctx, client, resourceGroup, name, d.Get("paused").(bool)); err != nil {
+			return err
+		}
+	}
+
 	serverProperties := expandAnalysisServicesServerMutableProperties(d)
 	sku := d.Get("sku").(string)
 	t := d.Get("tags").(map[string]interface{})
 
 	analysisServicesServer := analysisservices.ServerUpdateParameters{
-		Sku:                     &analysisservices.ResourceSku{Name: &sku},
+		Sku:                     expandAnalysisServicesServerSku(d, sku),
 		Tags:                    tags.Expand(t),
 		ServerMutableProperties: serverProperties,
 	}
@@ -315,6 +349,42 @@ func validateQuerypoolConnectionMode() schema.SchemaValidateFunc {
 	return validation.StringInSlice(connectionModes, true)
 }
 
+func expandAnalysisServicesServerSku(d *schema.ResourceData, sku string) *analysisservices.ResourceSku {
+	resourceSku := analysisservices.ResourceSku{Name: &sku}
+
+	if v, ok := d.GetOk("scale_out_query_replica_count"); ok {
+		resourceSku.Capacity = utils.Int32(int32(v.(int)))
+	}
+
+	return &resourceSku
+}
+
+func resourceArmAnalysisServicesServerSetPowerState(ctx context.Context, client *analysisservices.ServersClient, resourceGroup, name string, pause bool) error {
+	if pause {
+		future, err := client.Suspend(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error suspending Analysis Services Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for suspend of Analysis Services Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		return nil
+	}
+
+	future, err := client.Resume(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error resuming Analysis Services Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for resume of Analysis Services Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
 func expandAnalysisServicesServerProperties(d *schema.ResourceData) *analysisservices.ServerProperties {
 	adminUsers := expandAnalysisServicesServerAdminUsers(d)
 
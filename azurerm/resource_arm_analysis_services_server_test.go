@@ -69,6 +69,37 @@ func TestAccAzureRMAnalysisServicesServer_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMAnalysisServicesServer_scaleOutAndPaused(t *testing.T) {
+	resourceName := "azurerm_analysis_services_server.test"
+	ri := tf.AccRandTimeInt()
+	preConfig := testAccAzureRMAnalysisServicesServer_scaleOutAndPaused(ri, testLocation(), 1, false)
+	postConfig := testAccAzureRMAnalysisServicesServer_scaleOutAndPaused(ri, testLocation(), 2, true)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAnalysisServicesServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAnalysisServicesServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_out_query_replica_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "paused", "false"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAnalysisServicesServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_out_query_replica_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "paused", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMAnalysisServicesServer_querypoolConnectionMode(t *testing.T) {
 	resourceName := "azurerm_analysis_services_server.test"
 	ri := tf.AccRandTimeInt()
@@ -296,6 +327,24 @@ resource "azurerm_analysis_services_server" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMAnalysisServicesServer_scaleOutAndPaused(rInt int, location string, scaleOutQueryReplicaCount int, paused bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_analysis_services_server" "test" {
+  name                          = "acctestass%d"
+  location                      = "${azurerm_resource_group.test.location}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  sku                           = "B2"
+  scale_out_query_replica_count = %d
+  paused                        = %t
+}
+`, rInt, location, rInt, scaleOutQueryReplicaCount, paused)
+}
+
 func testAccAzureRMAnalysisServicesServer_querypoolConnectionMode(rInt int, location, connectionMode string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -34,6 +34,12 @@ func resourceArmAppServiceActiveSlot() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+
+			"preserve_vnet": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -46,7 +52,7 @@ func resourceArmAppServiceActiveSlotCreateUpdate(d *schema.ResourceData, meta in
 	appServiceName := d.Get("app_service_name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 	targetSlot := d.Get("app_service_slot_name").(string)
-	preserveVnet := true
+	preserveVnet := d.Get("preserve_vnet").(bool)
 
 	resp, err := client.Get(ctx, resGroup, appServiceName)
 	if err != nil {
@@ -57,6 +57,27 @@ func TestAccAzureRMAppServiceActiveSlot_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMAppServiceActiveSlot_preserveVnet(t *testing.T) {
+	resourceName := "azurerm_app_service_active_slot.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMAppServiceActiveSlot_preserveVnet(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// Destroy actually does nothing so we just return nil
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "preserve_vnet", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAzureRMAppServiceActiveSlot_basic(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -147,6 +168,48 @@ resource "azurerm_app_service_active_slot" "test" {
 `, rInt, location, rInt, rInt, rInt, rInt)
 }
 
+func testAccAzureRMAppServiceActiveSlot_preserveVnet(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+}
+
+resource "azurerm_app_service_slot" "test" {
+  name                = "acctestASSlot-%d"
+  app_service_name    = "${azurerm_app_service.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+}
+
+resource "azurerm_app_service_active_slot" "test" {
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  app_service_name      = "${azurerm_app_service.test.name}"
+  app_service_slot_name = "${azurerm_app_service_slot.test.name}"
+  preserve_vnet         = false
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
 func testAccAzureRMAppServiceActiveSlot_updated(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -0,0 +1,247 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppServiceCertificateOrder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceCertificateOrderCreateUpdate,
+		Read:   resourceArmAppServiceCertificateOrderRead,
+		Update: resourceArmAppServiceCertificateOrderCreateUpdate,
+		Delete: resourceArmAppServiceCertificateOrderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"distinguished_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"product_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(web.StandardDomainValidatedSsl),
+					string(web.StandardDomainValidatedWildCardSsl),
+				}, false),
+			},
+
+			"auto_renew": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"validity_in_years": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 3),
+			},
+
+			"key_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  2048,
+			},
+
+			"csr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"domain_verification_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmAppServiceCertificateOrderCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Web.CertificatesOrderClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for App Service Certificate Order creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	distinguishedName := d.Get("distinguished_name").(string)
+	productType := d.Get("product_type").(string)
+	autoRenew := d.Get("auto_renew").(bool)
+	validityInYears := d.Get("validity_in_years").(int)
+	keySize := d.Get("key_size").(int)
+	csr := d.Get("csr").(string)
+	t := d.Get("tags").(map[string]interface{})
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing App Service Certificate Order %q (Resource Group %q): %s", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_app_service_certificate_order", *existing.ID)
+		}
+	}
+
+	certificateOrder := web.AppServiceCertificateOrder{
+		AppServiceCertificateOrderProperties: &web.AppServiceCertificateOrderProperties{
+			DistinguishedName: utils.String(distinguishedName),
+			ProductType:       web.CertificateProductType(productType),
+			AutoRenew:         utils.Bool(autoRenew),
+			ValidityInYears:   utils.Int32(int32(validityInYears)),
+			KeySize:           utils.Int32(int32(keySize)),
+		},
+		Location: utils.String(location),
+		Tags:     tags.Expand(t),
+	}
+
+	if csr != "" {
+		certificateOrder.AppServiceCertificateOrderProperties.Csr = utils.String(csr)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, certificateOrder)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating App Service Certificate Order %q (Resource Group %q): %s", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of App Service Certificate Order %q (Resource Group %q): %s", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving App Service Certificate Order %q (Resource Group %q): %s", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read App Service Certificate Order %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAppServiceCertificateOrderRead(d, meta)
+}
+
+func resourceArmAppServiceCertificateOrderRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Web.CertificatesOrderClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["certificateOrders"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] App Service Certificate Order %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on App Service Certificate Order %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.AppServiceCertificateOrderProperties; props != nil {
+		d.Set("distinguished_name", props.DistinguishedName)
+		d.Set("product_type", string(props.ProductType))
+		d.Set("auto_renew", props.AutoRenew)
+		d.Set("validity_in_years", props.ValidityInYears)
+		d.Set("key_size", props.KeySize)
+		d.Set("csr", props.Csr)
+		d.Set("domain_verification_token", props.DomainVerificationToken)
+		d.Set("status", string(props.Status))
+
+		if expirationTime := props.ExpirationTime; expirationTime != nil {
+			d.Set("expiration_date", expirationTime.Format(time.RFC3339))
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmAppServiceCertificateOrderDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Web.CertificatesOrderClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["certificateOrders"]
+
+	log.Printf("[DEBUG] Deleting App Service Certificate Order %q (Resource Group %q)", name, resourceGroup)
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting App Service Certificate Order %q (Resource Group %q): %s", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
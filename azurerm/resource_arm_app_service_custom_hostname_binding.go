@@ -3,8 +3,10 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -118,6 +120,32 @@ func resourceArmAppServiceCustomHostnameBindingCreate(d *schema.ResourceData, me
 		properties.HostNameBindingProperties.Thumbprint = utils.String(thumbprint)
 	}
 
+	// Azure verifies domain ownership via the CNAME/TXT record before it'll accept the binding, so if the
+	// DNS record was only just created (e.g. in the same apply) the binding can fail until it's propagated -
+	// poll the hostname analysis until Azure considers it verified rather than failing the create outright.
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Pending"},
+		Target:     []string{"Verified"},
+		MinTimeout: 30 * time.Second,
+		Timeout:    10 * time.Minute,
+		Refresh: func() (interface{}, string, error) {
+			analysis, err := client.AnalyzeCustomHostname(ctx, resourceGroup, appServiceName, hostname)
+			if err != nil {
+				return nil, "Error", fmt.Errorf("Error analyzing Custom Hostname %q (App Service %q / Resource Group %q): %+v", hostname, appServiceName, resourceGroup, err)
+			}
+
+			if props := analysis.CustomHostnameAnalysisResultProperties; props != nil && props.IsHostnameAlreadyVerified != nil && *props.IsHostnameAlreadyVerified {
+				return analysis, "Verified", nil
+			}
+
+			return analysis, "Pending", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		log.Printf("[DEBUG] Custom Hostname %q (App Service %q / Resource Group %q) did not verify within the timeout - attempting the binding anyway: %+v", hostname, appServiceName, resourceGroup, err)
+	}
+
 	if _, err := client.CreateOrUpdateHostNameBinding(ctx, resourceGroup, appServiceName, hostname, properties); err != nil {
 		return err
 	}
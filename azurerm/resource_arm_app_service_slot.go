@@ -85,6 +85,8 @@ func resourceArmAppServiceSlot() *schema.Resource {
 				},
 			},
 
+			"storage_account": azure.SchemaAppServiceStorageAccounts(),
+
 			"connection_string": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -344,6 +346,17 @@ func resourceArmAppServiceSlotUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("storage_account") {
+		storageAccounts := azure.ExpandAppServiceStorageAccounts(d)
+		properties := web.AzureStoragePropertyDictionaryResource{
+			Properties: storageAccounts,
+		}
+
+		if _, err := client.UpdateAzureStorageAccountsSlot(ctx, resourceGroup, appServiceName, properties, slot); err != nil {
+			return fmt.Errorf("Error updating Storage Accounts for App Service Slot %q/%q: %+v", appServiceName, slot, err)
+		}
+	}
+
 	if d.HasChange("identity") {
 		identity := azure.ExpandAppServiceIdentity(d)
 		sitePatchResource := web.SitePatchResource{
@@ -421,6 +434,11 @@ func resourceArmAppServiceSlotRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error listing Connection Strings for Slot %q (App Service %q / Resource Group %q): %s", slot, appServiceName, resourceGroup, err)
 	}
 
+	storageAccountsResp, err := client.ListAzureStorageAccountsSlot(ctx, resourceGroup, appServiceName, slot)
+	if err != nil {
+		return fmt.Errorf("Error listing Storage Accounts for Slot %q (App Service %q / Resource Group %q): %s", slot, appServiceName, resourceGroup, err)
+	}
+
 	siteCredFuture, err := client.ListPublishingCredentialsSlot(ctx, resourceGroup, appServiceName, slot)
 	if err != nil {
 		return fmt.Errorf("Error retrieving publishing credentials for Slot %q (App Service %q / Resource Group %q): %s", slot, appServiceName, resourceGroup, err)
@@ -465,6 +483,10 @@ func resourceArmAppServiceSlotRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error setting `connection_string`: %s", err)
 	}
 
+	if err := d.Set("storage_account", azure.FlattenAppServiceStorageAccounts(storageAccountsResp.Properties)); err != nil {
+		return fmt.Errorf("Error setting `storage_account`: %s", err)
+	}
+
 	authSettings := azure.FlattenAppServiceAuthSettings(authResp.SiteAuthSettingsProperties)
 	if err := d.Set("auth_settings", authSettings); err != nil {
 		return fmt.Errorf("Error setting `auth_settings`: %s", err)
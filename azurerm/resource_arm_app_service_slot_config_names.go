@@ -0,0 +1,160 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppServiceSlotConfigNames() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceSlotConfigNamesCreateUpdate,
+		Read:   resourceArmAppServiceSlotConfigNamesRead,
+		Update: resourceArmAppServiceSlotConfigNamesCreateUpdate,
+		Delete: resourceArmAppServiceSlotConfigNamesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"app_service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"app_setting_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"connection_string_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"azure_storage_config_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceSlotConfigNamesCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Web.AppServicesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	appServiceName := d.Get("app_service_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	locks.ByName(appServiceName, appServiceResourceName)
+	defer locks.UnlockByName(appServiceName, appServiceResourceName)
+
+	app, err := client.Get(ctx, resourceGroup, appServiceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(app.Response) {
+			return fmt.Errorf("App Service %q (Resource Group %q) was not found", appServiceName, resourceGroup)
+		}
+		return fmt.Errorf("Error making Read request on App Service %q (Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+
+	slotConfigNames := web.SlotConfigNamesResource{
+		SlotConfigNames: &web.SlotConfigNames{
+			AppSettingNames:         utils.ExpandStringSlice(d.Get("app_setting_names").(*schema.Set).List()),
+			ConnectionStringNames:   utils.ExpandStringSlice(d.Get("connection_string_names").(*schema.Set).List()),
+			AzureStorageConfigNames: utils.ExpandStringSlice(d.Get("azure_storage_config_names").(*schema.Set).List()),
+		},
+	}
+
+	if _, err := client.UpdateSlotConfigurationNames(ctx, resourceGroup, appServiceName, slotConfigNames); err != nil {
+		return fmt.Errorf("Error updating Slot Config Names for App Service %q (Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+
+	d.SetId(*app.ID)
+
+	return resourceArmAppServiceSlotConfigNamesRead(d, meta)
+}
+
+func resourceArmAppServiceSlotConfigNamesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Web.AppServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+
+	app, err := client.Get(ctx, resourceGroup, appServiceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(app.Response) {
+			log.Printf("[DEBUG] App Service %q (Resource Group %q) was not found - removing from state", appServiceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on App Service %q (Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+
+	resp, err := client.ListSlotConfigurationNames(ctx, resourceGroup, appServiceName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Slot Config Names for App Service %q (Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+
+	d.Set("app_service_name", appServiceName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.SlotConfigNames; props != nil {
+		d.Set("app_setting_names", utils.FlattenStringSlice(props.AppSettingNames))
+		d.Set("connection_string_names", utils.FlattenStringSlice(props.ConnectionStringNames))
+		d.Set("azure_storage_config_names", utils.FlattenStringSlice(props.AzureStorageConfigNames))
+	}
+
+	return nil
+}
+
+func resourceArmAppServiceSlotConfigNamesDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Web.AppServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+
+	locks.ByName(appServiceName, appServiceResourceName)
+	defer locks.UnlockByName(appServiceName, appServiceResourceName)
+
+	log.Printf("[DEBUG] Clearing Slot Config Names for App Service %q (Resource Group %q)", appServiceName, resourceGroup)
+
+	slotConfigNames := web.SlotConfigNamesResource{
+		SlotConfigNames: &web.SlotConfigNames{
+			AppSettingNames:         &[]string{},
+			ConnectionStringNames:   &[]string{},
+			AzureStorageConfigNames: &[]string{},
+		},
+	}
+
+	if _, err := client.UpdateSlotConfigurationNames(ctx, resourceGroup, appServiceName, slotConfigNames); err != nil {
+		return fmt.Errorf("Error clearing Slot Config Names for App Service %q (Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppServiceSlotCustomHostnameBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceSlotCustomHostnameBindingCreate,
+		Read:   resourceArmAppServiceSlotCustomHostnameBindingRead,
+		Delete: resourceArmAppServiceSlotCustomHostnameBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"app_service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"app_service_slot_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"ssl_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(web.SslStateIPBasedEnabled),
+					string(web.SslStateSniEnabled),
+				}, false),
+			},
+
+			"thumbprint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"virtual_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceSlotCustomHostnameBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Web.AppServicesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for App Service Slot Hostname Binding creation.")
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	appServiceName := d.Get("app_service_name").(string)
+	slot := d.Get("app_service_slot_name").(string)
+	hostname := d.Get("hostname").(string)
+	sslState := d.Get("ssl_state").(string)
+	thumbprint := d.Get("thumbprint").(string)
+
+	locks.ByName(appServiceName, appServiceCustomHostnameBindingResourceName)
+	defer locks.UnlockByName(appServiceName, appServiceCustomHostnameBindingResourceName)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.GetHostNameBindingSlot(ctx, resourceGroup, appServiceName, slot, hostname)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Custom Hostname Binding %q (App Service %q / Slot %q / Resource Group %q): %s", hostname, appServiceName, slot, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_app_service_slot_custom_hostname_binding", *existing.ID)
+		}
+	}
+
+	properties := web.HostNameBinding{
+		HostNameBindingProperties: &web.HostNameBindingProperties{
+			SiteName: utils.String(fmt.Sprintf("%s(%s)", appServiceName, slot)),
+		},
+	}
+
+	if sslState != "" {
+		if thumbprint == "" {
+			return fmt.Errorf("`thumbprint` must be specified when `ssl_state` is set")
+		}
+
+		properties.HostNameBindingProperties.SslState = web.SslState(sslState)
+	}
+
+	if thumbprint != "" {
+		if sslState == "" {
+			return fmt.Errorf("`ssl_state` must be specified when `thumbprint` is set")
+		}
+
+		properties.HostNameBindingProperties.Thumbprint = utils.String(thumbprint)
+	}
+
+	// Azure verifies domain ownership via the CNAME/TXT record before it'll accept the binding, so if the
+	// DNS record was only just created (e.g. in the same apply) the binding can fail until it's propagated -
+	// poll the hostname analysis until Azure considers it verified rather than failing the create outright.
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Pending"},
+		Target:     []string{"Verified"},
+		MinTimeout: 30 * time.Second,
+		Timeout:    10 * time.Minute,
+		Refresh: func() (interface{}, string, error) {
+			analysis, err := client.AnalyzeCustomHostnameSlot(ctx, resourceGroup, appServiceName, slot, hostname)
+			if err != nil {
+				return nil, "Error", fmt.Errorf("Error analyzing Custom Hostname %q (App Service %q / Slot %q / Resource Group %q): %+v", hostname, appServiceName, slot, resourceGroup, err)
+			}
+
+			if props := analysis.CustomHostnameAnalysisResultProperties; props != nil && props.IsHostnameAlreadyVerified != nil && *props.IsHostnameAlreadyVerified {
+				return analysis, "Verified", nil
+			}
+
+			return analysis, "Pending", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		log.Printf("[DEBUG] Custom Hostname %q (App Service %q / Slot %q / Resource Group %q) did not verify within the timeout - attempting the binding anyway: %+v", hostname, appServiceName, slot, resourceGroup, err)
+	}
+
+	if _, err := client.CreateOrUpdateHostNameBindingSlot(ctx, resourceGroup, appServiceName, hostname, properties, slot); err != nil {
+		return err
+	}
+
+	read, err := client.GetHostNameBindingSlot(ctx, resourceGroup, appServiceName, slot, hostname)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Hostname Binding %q (App Service %q / Slot %q / Resource Group %q) ID", hostname, appServiceName, slot, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAppServiceSlotCustomHostnameBindingRead(d, meta)
+}
+
+func resourceArmAppServiceSlotCustomHostnameBindingRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Web.AppServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	slot := id.Path["slots"]
+	hostname := id.Path["hostNameBindings"]
+
+	resp, err := client.GetHostNameBindingSlot(ctx, resourceGroup, appServiceName, slot, hostname)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] App Service Slot Hostname Binding %q (App Service %q / Slot %q / Resource Group %q) was not found - removing from state", hostname, appServiceName, slot, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on App Service Slot Hostname Binding %q (App Service %q / Slot %q / Resource Group %q): %+v", hostname, appServiceName, slot, resourceGroup, err)
+	}
+
+	d.Set("hostname", hostname)
+	d.Set("app_service_name", appServiceName)
+	d.Set("app_service_slot_name", slot)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.HostNameBindingProperties; props != nil {
+		d.Set("ssl_state", props.SslState)
+		d.Set("thumbprint", props.Thumbprint)
+		d.Set("virtual_ip", props.VirtualIP)
+	}
+
+	return nil
+}
+
+func resourceArmAppServiceSlotCustomHostnameBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Web.AppServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	slot := id.Path["slots"]
+	hostname := id.Path["hostNameBindings"]
+
+	locks.ByName(appServiceName, appServiceCustomHostnameBindingResourceName)
+	defer locks.UnlockByName(appServiceName, appServiceCustomHostnameBindingResourceName)
+
+	log.Printf("[DEBUG] Deleting App Service Slot Hostname Binding %q (App Service %q / Slot %q / Resource Group %q)", hostname, appServiceName, slot, resGroup)
+
+	resp, err := client.DeleteHostNameBindingSlot(ctx, resGroup, appServiceName, slot, hostname)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppServiceSourceControl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceSourceControlCreateUpdate,
+		Read:   resourceArmAppServiceSourceControlRead,
+		Update: resourceArmAppServiceSourceControlCreateUpdate,
+		Delete: resourceArmAppServiceSourceControlDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_service_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"repo_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"branch": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"manual_integration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"use_mercurial": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"rollback_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceSourceControlCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Web.AppServicesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for App Service Source Control creation.")
+
+	appServiceId := d.Get("app_service_id").(string)
+	repoUrl := d.Get("repo_url").(string)
+	branch := d.Get("branch").(string)
+	manualIntegration := d.Get("manual_integration").(bool)
+	useMercurial := d.Get("use_mercurial").(bool)
+	rollbackEnabled := d.Get("rollback_enabled").(bool)
+
+	parsedAppServiceId, err := azure.ParseAzureResourceID(appServiceId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := parsedAppServiceId.ResourceGroup
+	appServiceName := parsedAppServiceId.Path["sites"]
+
+	locks.ByName(appServiceName, appServiceResourceName)
+	defer locks.UnlockByName(appServiceName, appServiceResourceName)
+
+	siteSourceControl := web.SiteSourceControl{
+		SiteSourceControlProperties: &web.SiteSourceControlProperties{
+			RepoURL:                   utils.String(repoUrl),
+			Branch:                    utils.String(branch),
+			IsManualIntegration:       utils.Bool(manualIntegration),
+			IsMercurial:               utils.Bool(useMercurial),
+			DeploymentRollbackEnabled: utils.Bool(rollbackEnabled),
+		},
+	}
+
+	future, err := client.CreateOrUpdateSourceControl(ctx, resourceGroup, appServiceName, siteSourceControl)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Source Control (App Service %q / Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Source Control (App Service %q / Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+
+	read, err := client.GetSourceControl(ctx, resourceGroup, appServiceName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Source Control (App Service %q / Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Source Control (App Service %q / Resource Group %q) ID", appServiceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAppServiceSourceControlRead(d, meta)
+}
+
+func resourceArmAppServiceSourceControlRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Web.AppServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+
+	resp, err := client.GetSourceControl(ctx, resourceGroup, appServiceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Source Control (App Service %q / Resource Group %q) was not found - removing from state", appServiceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Source Control (App Service %q / Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+
+	appServiceId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s", id.SubscriptionID, resourceGroup, appServiceName)
+	d.Set("app_service_id", appServiceId)
+
+	if props := resp.SiteSourceControlProperties; props != nil {
+		d.Set("repo_url", props.RepoURL)
+		d.Set("branch", props.Branch)
+		d.Set("manual_integration", props.IsManualIntegration)
+		d.Set("use_mercurial", props.IsMercurial)
+		d.Set("rollback_enabled", props.DeploymentRollbackEnabled)
+	}
+
+	return nil
+}
+
+func resourceArmAppServiceSourceControlDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Web.AppServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+
+	locks.ByName(appServiceName, appServiceResourceName)
+	defer locks.UnlockByName(appServiceName, appServiceResourceName)
+
+	log.Printf("[DEBUG] Deleting Source Control (App Service %q / Resource Group %q)", appServiceName, resourceGroup)
+
+	if resp, err := client.DeleteSourceControl(ctx, resourceGroup, appServiceName); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Source Control (App Service %q / Resource Group %q): %+v", appServiceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
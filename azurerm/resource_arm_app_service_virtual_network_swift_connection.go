@@ -0,0 +1,183 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var appServiceResourceName = "azurerm_app_service"
+
+func resourceArmAppServiceVirtualNetworkSwiftConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceVirtualNetworkSwiftConnectionCreateUpdate,
+		Read:   resourceArmAppServiceVirtualNetworkSwiftConnectionRead,
+		Update: resourceArmAppServiceVirtualNetworkSwiftConnectionCreateUpdate,
+		Delete: resourceArmAppServiceVirtualNetworkSwiftConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_service_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceVirtualNetworkSwiftConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Web.AppServicesClient
+	subnetsClient := meta.(*ArmClient).Network.SubnetsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for App Service <-> Virtual Network Swift Connection creation.")
+
+	appServiceId := d.Get("app_service_id").(string)
+	subnetId := d.Get("subnet_id").(string)
+
+	parsedAppServiceId, err := azure.ParseAzureResourceID(appServiceId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := parsedAppServiceId.ResourceGroup
+	appServiceName := parsedAppServiceId.Path["sites"]
+
+	parsedSubnetId, err := azure.ParseAzureResourceID(subnetId)
+	if err != nil {
+		return err
+	}
+	virtualNetworkName := parsedSubnetId.Path["virtualNetworks"]
+	subnetName := parsedSubnetId.Path["subnets"]
+
+	locks.ByName(appServiceName, appServiceResourceName)
+	defer locks.UnlockByName(appServiceName, appServiceResourceName)
+
+	locks.ByName(virtualNetworkName, virtualNetworkResourceName)
+	defer locks.UnlockByName(virtualNetworkName, virtualNetworkResourceName)
+
+	locks.ByName(subnetName, subnetResourceName)
+	defer locks.UnlockByName(subnetName, subnetResourceName)
+
+	subnet, err := subnetsClient.Get(ctx, parsedSubnetId.ResourceGroup, virtualNetworkName, subnetName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, parsedSubnetId.ResourceGroup, err)
+	}
+
+	delegated := false
+	if props := subnet.SubnetPropertiesFormat; props != nil && props.Delegations != nil {
+		for _, delegation := range *props.Delegations {
+			if delegation.ServiceDelegationPropertiesFormat == nil || delegation.ServiceDelegationPropertiesFormat.ServiceName == nil {
+				continue
+			}
+
+			if *delegation.ServiceDelegationPropertiesFormat.ServiceName == "Microsoft.Web/serverFarms" {
+				delegated = true
+				break
+			}
+		}
+	}
+
+	if !delegated {
+		return fmt.Errorf("Subnet %q (Virtual Network %q / Resource Group %q) must have a `Microsoft.Web/serverFarms` delegation to be used for Regional VNet Integration", subnetName, virtualNetworkName, parsedSubnetId.ResourceGroup)
+	}
+
+	connectionEnvelope := web.SwiftVirtualNetwork{
+		SwiftVirtualNetworkProperties: &web.SwiftVirtualNetworkProperties{
+			SubnetResourceID: utils.String(subnetId),
+		},
+	}
+	if _, err := client.CreateOrUpdateSwiftVirtualNetworkConnection(ctx, resourceGroup, appServiceName, connectionEnvelope); err != nil {
+		return fmt.Errorf("Error creating/updating Virtual Network Swift Connection (App Service %q / Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+
+	read, err := client.GetSwiftVirtualNetworkConnection(ctx, resourceGroup, appServiceName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Network Swift Connection (App Service %q / Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Virtual Network Swift Connection (App Service %q / Resource Group %q) ID", appServiceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAppServiceVirtualNetworkSwiftConnectionRead(d, meta)
+}
+
+func resourceArmAppServiceVirtualNetworkSwiftConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Web.AppServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+
+	resp, err := client.GetSwiftVirtualNetworkConnection(ctx, resourceGroup, appServiceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Virtual Network Swift Connection (App Service %q / Resource Group %q) was not found - removing from state", appServiceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Virtual Network Swift Connection (App Service %q / Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+
+	props := resp.SwiftVirtualNetworkProperties
+	if props == nil || props.SubnetResourceID == nil || *props.SubnetResourceID == "" {
+		log.Printf("[DEBUG] Virtual Network Swift Connection (App Service %q / Resource Group %q) has no Subnet - removing from state", appServiceName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	appServiceId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s", id.SubscriptionID, resourceGroup, appServiceName)
+	d.Set("app_service_id", appServiceId)
+	d.Set("subnet_id", props.SubnetResourceID)
+
+	return nil
+}
+
+func resourceArmAppServiceVirtualNetworkSwiftConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Web.AppServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+
+	log.Printf("[DEBUG] Deleting Virtual Network Swift Connection (App Service %q / Resource Group %q)", appServiceName, resourceGroup)
+
+	connectionEnvelope := web.SwiftVirtualNetwork{
+		SwiftVirtualNetworkProperties: &web.SwiftVirtualNetworkProperties{
+			SubnetResourceID: utils.String(""),
+		},
+	}
+	if _, err := client.CreateOrUpdateSwiftVirtualNetworkConnection(ctx, resourceGroup, appServiceName, connectionEnvelope); err != nil {
+		return fmt.Errorf("Error removing Virtual Network Swift Connection (App Service %q / Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAppServiceVirtualNetworkSwiftConnection_basic(t *testing.T) {
+	resourceName := "azurerm_app_service_virtual_network_swift_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// intentional as this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceVirtualNetworkSwiftConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceVirtualNetworkSwiftConnectionExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppServiceVirtualNetworkSwiftConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		appServiceId := rs.Primary.Attributes["app_service_id"]
+		parsedId, err := azure.ParseAzureResourceID(appServiceId)
+		if err != nil {
+			return err
+		}
+
+		resourceGroupName := parsedId.ResourceGroup
+		appServiceName := parsedId.Path["sites"]
+
+		client := testAccProvider.Meta().(*ArmClient).Web.AppServicesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.GetSwiftVirtualNetworkConnection(ctx, resourceGroupName, appServiceName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Virtual Network Swift Connection (App Service %q / Resource Group: %q) does not exist", appServiceName, resourceGroupName)
+			}
+
+			return fmt.Errorf("Bad: Get on AppServicesClient: %+v", err)
+		}
+
+		props := resp.SwiftVirtualNetworkProperties
+		if props == nil || props.SubnetResourceID == nil || *props.SubnetResourceID == "" {
+			return fmt.Errorf("No Virtual Network Swift Connection exists for App Service %q (Resource Group: %q)", appServiceName, resourceGroupName)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAppServiceVirtualNetworkSwiftConnection_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsn-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefixes     = ["10.0.1.0/24"]
+
+  delegation {
+    name = "delegation"
+
+    service_delegation {
+      name    = "Microsoft.Web/serverFarms"
+      actions = ["Microsoft.Network/virtualNetworks/subnets/action"]
+    }
+  }
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+}
+
+resource "azurerm_app_service_virtual_network_swift_connection" "test" {
+  app_service_id = "${azurerm_app_service.test.id}"
+  subnet_id      = "${azurerm_subnet.test.id}"
+}
+`, rInt, location, rInt, rInt, rInt, rInt)
+}
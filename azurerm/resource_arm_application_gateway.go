@@ -818,6 +818,12 @@ func resourceArmApplicationGateway() *schema.Resource {
 				Optional: true,
 			},
 
+			"firewall_policy_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
 			"probe": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -1422,6 +1428,12 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 		},
 	}
 
+	if v, ok := d.GetOk("firewall_policy_id"); ok {
+		gateway.ApplicationGatewayPropertiesFormat.FirewallPolicy = &network.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
 	if _, ok := d.GetOk("identity"); ok {
 		gateway.Identity = expandAzureRmApplicationGatewayIdentity(d)
 	}
@@ -1569,6 +1581,10 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 
 		d.Set("enable_http2", props.EnableHTTP2)
 
+		if firewallPolicy := props.FirewallPolicy; firewallPolicy != nil {
+			d.Set("firewall_policy_id", firewallPolicy.ID)
+		}
+
 		httpListeners, err := flattenApplicationGatewayHTTPListeners(props.HTTPListeners)
 		if err != nil {
 			return fmt.Errorf("Error flattening `http_listener`: %+v", err)
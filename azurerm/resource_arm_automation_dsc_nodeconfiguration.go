@@ -44,9 +44,52 @@ func resourceArmAutomationDscNodeConfiguration() *schema.Resource {
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
 			"content_embedded": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validate.NoEmptyStrings,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"content_link"},
+			},
+
+			"content_link": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"content_embedded"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"hash": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"algorithm": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"increment_node_configuration_build": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 
 			"configuration_name": {
@@ -81,7 +124,20 @@ func resourceArmAutomationDscNodeConfigurationCreateUpdate(d *schema.ResourceDat
 		}
 	}
 
-	content := d.Get("content_embedded").(string)
+	content, contentOk := d.GetOk("content_embedded")
+	contentLinks := d.Get("content_link").([]interface{})
+
+	var source *automation.ContentSource
+	if contentOk {
+		source = &automation.ContentSource{
+			Type:  automation.EmbeddedContent,
+			Value: utils.String(content.(string)),
+		}
+	} else if len(contentLinks) > 0 {
+		source = expandAutomationDscNodeConfigurationContentLink(contentLinks)
+	} else {
+		return fmt.Errorf("Either `content_embedded` or `content_link` must be specified")
+	}
 
 	// configuration name is always the first part of the dsc node configuration
 	// e.g. webserver.prod or webserver.local will be associated to the dsc configuration webserver
@@ -89,14 +145,12 @@ func resourceArmAutomationDscNodeConfigurationCreateUpdate(d *schema.ResourceDat
 	configurationName := strings.Split(name, ".")[0]
 
 	parameters := automation.DscNodeConfigurationCreateOrUpdateParameters{
-		Source: &automation.ContentSource{
-			Type:  automation.EmbeddedContent,
-			Value: utils.String(content),
-		},
+		Source: source,
 		Configuration: &automation.DscConfigurationAssociationProperty{
 			Name: utils.String(configurationName),
 		},
-		Name: utils.String(name),
+		Name:                            utils.String(name),
+		IncrementNodeConfigurationBuild: utils.Bool(d.Get("increment_node_configuration_build").(bool)),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resGroup, accName, name, parameters); err != nil {
@@ -174,3 +228,24 @@ func resourceArmAutomationDscNodeConfigurationDelete(d *schema.ResourceData, met
 
 	return nil
 }
+
+func expandAutomationDscNodeConfigurationContentLink(input []interface{}) *automation.ContentSource {
+	contentLink := input[0].(map[string]interface{})
+	uri := contentLink["uri"].(string)
+
+	source := automation.ContentSource{
+		Type:  automation.URI,
+		Value: utils.String(uri),
+	}
+
+	hashes := contentLink["hash"].([]interface{})
+	if len(hashes) > 0 {
+		hash := hashes[0].(map[string]interface{})
+		source.Hash = &automation.ContentHash{
+			Algorithm: utils.String(hash["algorithm"].(string)),
+			Value:     utils.String(hash["value"].(string)),
+		}
+	}
+
+	return &source
+}
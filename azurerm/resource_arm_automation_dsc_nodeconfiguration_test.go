@@ -191,6 +191,78 @@ mofcontent
 `, rInt, location, rInt)
 }
 
+func TestAccAzureRMAutomationDscNodeConfiguration_contentLink(t *testing.T) {
+	resourceName := "azurerm_automation_dsc_nodeconfiguration.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationDscNodeConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationDscNodeConfiguration_contentLink(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationDscNodeConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration_name", "acctest"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Cannot check content_link at this time as it is not exposed via REST API / Azure SDK
+				ImportStateVerifyIgnore: []string{"content_link", "increment_node_configuration_build"},
+			},
+		},
+	})
+}
+
+func testAccAzureRMAutomationDscNodeConfiguration_contentLink(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Basic"
+  }
+}
+
+resource "azurerm_automation_dsc_configuration" "test" {
+  name                    = "acctest"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  automation_account_name = "${azurerm_automation_account.test.name}"
+  location                = "${azurerm_resource_group.test.location}"
+  content_embedded        = "configuration acctest {}"
+}
+
+resource "azurerm_automation_dsc_nodeconfiguration" "test" {
+  name                    = "acctest.localhost"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  automation_account_name = "${azurerm_automation_account.test.name}"
+  depends_on              = ["azurerm_automation_dsc_configuration.test"]
+
+  content_link {
+    uri = "https://raw.githubusercontent.com/terraform-providers/terraform-provider-azurerm/master/azurerm/testdata/acctest.localhost.mof"
+
+    hash {
+      algorithm = "SHA256"
+      value     = "6DE256A57F01BB2490FD853BBB9D739BE73D723F709C9C89C1524A1D7D1F0F9"
+    }
+  }
+
+  increment_node_configuration_build = true
+}
+`, rInt, location, rInt)
+}
+
 func testAccAzureRMAutomationDscNodeConfiguration_requiresImport(rInt int, location string) string {
 	template := testAccAzureRMAutomationDscNodeConfiguration_basic(rInt, location)
 	return fmt.Sprintf(`
@@ -0,0 +1,154 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAutomationHybridRunbookWorkerGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAutomationHybridRunbookWorkerGroupCreateUpdate,
+		Read:   resourceArmAutomationHybridRunbookWorkerGroupRead,
+		Update: resourceArmAutomationHybridRunbookWorkerGroupCreateUpdate,
+		Delete: resourceArmAutomationHybridRunbookWorkerGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"automation_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"credential_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmAutomationHybridRunbookWorkerGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Automation.HybridRunbookWorkerGroupClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Automation Hybrid Runbook Worker Group creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	accName := d.Get("automation_account_name").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, accName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Automation Hybrid Runbook Worker Group %q (Account %q / Resource Group %q): %s", name, accName, resGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_automation_hybrid_runbook_worker_group", *existing.ID)
+		}
+	}
+
+	parameters := automation.HybridRunbookWorkerGroupUpdateParameters{}
+
+	if credentialName, ok := d.GetOk("credential_name"); ok {
+		parameters.Credential = &automation.RunAsCredentialAssociationProperty{
+			Name: utils.String(credentialName.(string)),
+		}
+	}
+
+	if _, err := client.Update(ctx, resGroup, accName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Automation Hybrid Runbook Worker Group %q (Account %q / Resource Group %q): %+v", name, accName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, accName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Automation Hybrid Runbook Worker Group %q (Account %q / Resource Group %q): %+v", name, accName, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Automation Hybrid Runbook Worker Group %q (Account %q / Resource Group %q) ID", name, accName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAutomationHybridRunbookWorkerGroupRead(d, meta)
+}
+
+func resourceArmAutomationHybridRunbookWorkerGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Automation.HybridRunbookWorkerGroupClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	accName := id.Path["automationAccounts"]
+	name := id.Path["hybridRunbookWorkerGroups"]
+
+	resp, err := client.Get(ctx, resGroup, accName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Automation Hybrid Runbook Worker Group %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Automation Hybrid Runbook Worker Group %q (Account %q / Resource Group %q): %+v", name, accName, resGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("automation_account_name", accName)
+
+	if credential := resp.Credential; credential != nil {
+		d.Set("credential_name", credential.Name)
+	}
+
+	return nil
+}
+
+func resourceArmAutomationHybridRunbookWorkerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Automation.HybridRunbookWorkerGroupClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	accName := id.Path["automationAccounts"]
+	name := id.Path["hybridRunbookWorkerGroups"]
+
+	resp, err := client.Delete(ctx, resGroup, accName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Automation Hybrid Runbook Worker Group %q (Account %q / Resource Group %q): %+v", name, accName, resGroup, err)
+		}
+	}
+
+	return nil
+}
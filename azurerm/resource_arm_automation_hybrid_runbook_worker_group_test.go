@@ -0,0 +1,168 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAutomationHybridRunbookWorkerGroup_basic(t *testing.T) {
+	resourceName := "azurerm_automation_hybrid_runbook_worker_group.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationHybridRunbookWorkerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationHybridRunbookWorkerGroup_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationHybridRunbookWorkerGroupExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAutomationHybridRunbookWorkerGroup_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_automation_hybrid_runbook_worker_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationHybridRunbookWorkerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationHybridRunbookWorkerGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationHybridRunbookWorkerGroupExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMAutomationHybridRunbookWorkerGroup_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_automation_hybrid_runbook_worker_group"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAutomationHybridRunbookWorkerGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).Automation.HybridRunbookWorkerGroupClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_automation_hybrid_runbook_worker_group" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accName := rs.Primary.Attributes["automation_account_name"]
+
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Automation Hybrid Runbook Worker Group: '%s'", name)
+		}
+
+		resp, err := conn.Get(ctx, resourceGroup, accName, name)
+
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Automation Hybrid Runbook Worker Group still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMAutomationHybridRunbookWorkerGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accName := rs.Primary.Attributes["automation_account_name"]
+
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Automation Hybrid Runbook Worker Group: '%s'", name)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).Automation.HybridRunbookWorkerGroupClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := conn.Get(ctx, resourceGroup, accName, name)
+
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Automation Hybrid Runbook Worker Group '%s' (resource group: '%s') does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on automationHybridRunbookWorkerGroupClient: %s\nName: %s, Account name: %s, Resource group: %s OBJECT: %+v", err, name, accName, resourceGroup, rs.Primary)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAutomationHybridRunbookWorkerGroup_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Basic"
+  }
+}
+
+resource "azurerm_automation_hybrid_runbook_worker_group" "test" {
+  name                     = "acctest-%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  automation_account_name  = "${azurerm_automation_account.test.name}"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMAutomationHybridRunbookWorkerGroup_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMAutomationHybridRunbookWorkerGroup_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_hybrid_runbook_worker_group" "import" {
+  name                     = "${azurerm_automation_hybrid_runbook_worker_group.test.name}"
+  resource_group_name      = "${azurerm_automation_hybrid_runbook_worker_group.test.resource_group_name}"
+  automation_account_name  = "${azurerm_automation_hybrid_runbook_worker_group.test.automation_account_name}"
+}
+`, template)
+}
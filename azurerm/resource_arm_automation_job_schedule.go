@@ -0,0 +1,214 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAutomationJobSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAutomationJobScheduleCreate,
+		Read:   resourceArmAutomationJobScheduleRead,
+		Delete: resourceArmAutomationJobScheduleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"automation_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"schedule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"runbook_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"run_on": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"job_schedule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAutomationJobScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Automation.JobScheduleClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Automation Job Schedule creation.")
+
+	resGroup := d.Get("resource_group_name").(string)
+	accName := d.Get("automation_account_name").(string)
+	scheduleName := d.Get("schedule_name").(string)
+	runbookName := d.Get("runbook_name").(string)
+
+	existingJobSchedules, err := client.ListByAutomationAccount(ctx, resGroup, accName, "")
+	if err != nil {
+		return fmt.Errorf("Error checking for presence of existing Automation Job Schedules (Account %q / Resource Group %q): %s", accName, resGroup, err)
+	}
+	for existingJobSchedules.NotDone() {
+		for _, existingJobSchedule := range existingJobSchedules.Values() {
+			properties := existingJobSchedule.JobScheduleProperties
+			if properties == nil || properties.Schedule == nil || properties.Runbook == nil || properties.JobScheduleID == nil {
+				continue
+			}
+			if properties.Schedule.Name != nil && *properties.Schedule.Name == scheduleName &&
+				properties.Runbook.Name != nil && *properties.Runbook.Name == runbookName {
+				return tf.ImportAsExistsError("azurerm_automation_job_schedule", *properties.JobScheduleID)
+			}
+		}
+
+		if err := existingJobSchedules.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error checking for presence of existing Automation Job Schedules (Account %q / Resource Group %q): %s", accName, resGroup, err)
+		}
+	}
+
+	jobScheduleUUID := uuid.NewV4()
+
+	parameters := map[string]*string{}
+	for k, v := range d.Get("parameters").(map[string]interface{}) {
+		parameters[k] = utils.String(v.(string))
+	}
+
+	createParameters := automation.JobScheduleCreateParameters{
+		JobScheduleCreateProperties: &automation.JobScheduleCreateProperties{
+			Schedule: &automation.ScheduleAssociationProperty{
+				Name: utils.String(scheduleName),
+			},
+			Runbook: &automation.RunbookAssociationProperty{
+				Name: utils.String(runbookName),
+			},
+			Parameters: parameters,
+		},
+	}
+
+	if v, ok := d.GetOk("run_on"); ok {
+		createParameters.JobScheduleCreateProperties.RunOn = utils.String(v.(string))
+	}
+
+	read, err := client.Create(ctx, resGroup, accName, jobScheduleUUID, createParameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Automation Job Schedule (Account %q / Resource Group %q): %+v", accName, resGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Automation Job Schedule (Account %q / Resource Group %q) ID", accName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAutomationJobScheduleRead(d, meta)
+}
+
+func resourceArmAutomationJobScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Automation.JobScheduleClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	accName := id.Path["automationAccounts"]
+	jobScheduleID, err := uuid.FromString(id.Path["jobSchedules"])
+	if err != nil {
+		return fmt.Errorf("Error parsing Job Schedule ID %q: %+v", id.Path["jobSchedules"], err)
+	}
+
+	resp, err := client.Get(ctx, resGroup, accName, jobScheduleID)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on AzureRM Automation Job Schedule %q (Account %q / Resource Group %q): %+v", jobScheduleID, accName, resGroup, err)
+	}
+
+	d.Set("resource_group_name", resGroup)
+	d.Set("automation_account_name", accName)
+
+	if props := resp.JobScheduleProperties; props != nil {
+		d.Set("job_schedule_id", props.JobScheduleID)
+		if props.Schedule != nil {
+			d.Set("schedule_name", props.Schedule.Name)
+		}
+		if props.Runbook != nil {
+			d.Set("runbook_name", props.Runbook.Name)
+		}
+		d.Set("run_on", props.RunOn)
+	}
+
+	// the API always returns parameter names upper-cased, so pull the user-supplied casing back out of state
+	// rather than round-tripping through the Read and causing a perpetual diff
+	if v, ok := d.GetOk("parameters"); ok {
+		d.Set("parameters", v)
+	}
+
+	return nil
+}
+
+func resourceArmAutomationJobScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Automation.JobScheduleClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	accName := id.Path["automationAccounts"]
+	jobScheduleID, err := uuid.FromString(id.Path["jobSchedules"])
+	if err != nil {
+		return fmt.Errorf("Error parsing Job Schedule ID %q: %+v", id.Path["jobSchedules"], err)
+	}
+
+	if _, err := client.Delete(ctx, resGroup, accName, jobScheduleID); err != nil {
+		return fmt.Errorf("Error issuing AzureRM delete request for Automation Job Schedule %q: %+v", jobScheduleID, err)
+	}
+
+	return nil
+}
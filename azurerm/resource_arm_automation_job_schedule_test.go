@@ -0,0 +1,170 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAutomationJobSchedule_basic(t *testing.T) {
+	resourceName := "azurerm_automation_job_schedule.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationJobScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationJobSchedule_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationJobScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.vmname", "TestVm"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAutomationJobScheduleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).Automation.JobScheduleClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_automation_job_schedule" {
+			continue
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		accName := id.Path["automationAccounts"]
+		jobScheduleID, err := uuid.FromString(id.Path["jobSchedules"])
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.Get(ctx, resourceGroup, accName, jobScheduleID)
+
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Automation Job Schedule still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMAutomationJobScheduleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		accName := id.Path["automationAccounts"]
+		jobScheduleID, err := uuid.FromString(id.Path["jobSchedules"])
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).Automation.JobScheduleClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := conn.Get(ctx, resourceGroup, accName, jobScheduleID)
+
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Automation Job Schedule (resource group: %q / account: %q) does not exist", resourceGroup, accName)
+			}
+
+			return fmt.Errorf("Bad: Get on automationJobScheduleClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAutomationJobSchedule_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Basic"
+  }
+}
+
+resource "azurerm_automation_runbook" "test" {
+  name                = "Get-AzureVMTutorial"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  account_name = "${azurerm_automation_account.test.name}"
+  log_verbose  = "true"
+  log_progress = "true"
+  description  = "This is a test runbook for terraform acceptance test"
+  runbook_type = "PowerShellWorkflow"
+
+  publish_content_link {
+    uri = "https://raw.githubusercontent.com/Azure/azure-quickstart-templates/master/101-automation-runbook-getvms/Runbooks/Get-AzureVMTutorial.ps1"
+  }
+}
+
+resource "azurerm_automation_schedule" "test" {
+  name                    = "acctestsched-%d"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  automation_account_name = "${azurerm_automation_account.test.name}"
+  frequency               = "Week"
+  interval                = 1
+  timezone                = "Central Europe Standard Time"
+  start_time              = "2034-04-15T18:00:15+02:00"
+  description             = "This is an acceptance test schedule"
+  week_days               = ["Friday"]
+}
+
+resource "azurerm_automation_job_schedule" "test" {
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  automation_account_name = "${azurerm_automation_account.test.name}"
+  schedule_name           = "${azurerm_automation_schedule.test.name}"
+  runbook_name            = "${azurerm_automation_runbook.test.name}"
+
+  parameters = {
+    vmname = "TestVm"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
@@ -82,6 +82,12 @@ func resourceArmAutomationRunbook() *schema.Resource {
 				Computed: true,
 			},
 
+			"publish": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"publish_content_link": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -184,8 +190,10 @@ func resourceArmAutomationRunbookCreateUpdate(d *schema.ResourceData, meta inter
 			return fmt.Errorf("Error setting the draft Automation Runbook %q (Account %q / Resource Group %q): %+v", name, accName, resGroup, err)
 		}
 
-		if _, err := draftClient.Publish(ctx, resGroup, accName, name); err != nil {
-			return fmt.Errorf("Error publishing the updated Automation Runbook %q (Account %q / Resource Group %q): %+v", name, accName, resGroup, err)
+		if d.Get("publish").(bool) {
+			if _, err := draftClient.Publish(ctx, resGroup, accName, name); err != nil {
+				return fmt.Errorf("Error publishing the updated Automation Runbook %q (Account %q / Resource Group %q): %+v", name, accName, resGroup, err)
+			}
 		}
 	}
 
@@ -119,6 +119,33 @@ func TestAccAzureRMAutomationRunbook_PSWithContent(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMAutomationRunbook_PSWithContentUnpublished(t *testing.T) {
+	resourceName := "azurerm_automation_runbook.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationRunbookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationRunbook_PSWithContentUnpublished(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationRunbookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "runbook_type", "PowerShell"),
+					resource.TestCheckResourceAttr(resourceName, "publish", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish_content_link"},
+			},
+		},
+	})
+}
+
 func testCheckAzureRMAutomationRunbookDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*ArmClient).Automation.RunbookClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -323,3 +350,44 @@ CONTENT
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMAutomationRunbook_PSWithContentUnpublished(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Basic"
+  }
+}
+
+resource "azurerm_automation_runbook" "test" {
+  name                = "Get-AzureVMTutorial"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  account_name = "${azurerm_automation_account.test.name}"
+  log_verbose  = "true"
+  log_progress = "true"
+  description  = "This is a test runbook for terraform acceptance test"
+  runbook_type = "PowerShell"
+  publish      = false
+
+  publish_content_link {
+    uri = "https://raw.githubusercontent.com/Azure/azure-quickstart-templates/master/101-automation-runbook-getvms/Runbooks/Get-AzureVMTutorial.ps1"
+  }
+
+  content = <<CONTENT
+# Some test content
+# for Terraform acceptance test
+CONTENT
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,391 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCosmosDbCassandraTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCosmosDbCassandraTableCreateUpdate,
+		Read:   resourceArmCosmosDbCassandraTableRead,
+		Update: resourceArmCosmosDbCassandraTableCreateUpdate,
+		Delete: resourceArmCosmosDbCassandraTableDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosAccountName,
+			},
+
+			"cassandra_keyspace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosEntityName,
+			},
+
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+
+			"throughput": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      400,
+				ValidateFunc: validate.CosmosThroughput,
+			},
+
+			"schema": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
+
+						"partition_key": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
+
+						"cluster_key": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+									"order_by": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"Asc",
+											"Desc",
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmCosmosDbCassandraTableCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	account := d.Get("account_name").(string)
+	throughput := d.Get("throughput").(int)
+	keyspace := d.Get("cassandra_keyspace_name").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.GetCassandraTable(ctx, resourceGroup, account, keyspace, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of creating Cosmos Cassandra Table %s (Account %s, Keyspace %s): %+v", name, account, keyspace, err)
+			}
+		} else {
+			id, err := azure.CosmosGetIDFromResponse(existing.Response)
+			if err != nil {
+				return fmt.Errorf("Error generating import ID for Cosmos Cassandra Table %s (Account %s, Keyspace %s)", name, account, keyspace)
+			}
+
+			return tf.ImportAsExistsError("azurerm_cosmosdb_cassandra_table", id)
+		}
+	}
+
+	db := documentdb.CassandraTableCreateUpdateParameters{
+		CassandraTableCreateUpdateProperties: &documentdb.CassandraTableCreateUpdateProperties{
+			Resource: &documentdb.CassandraTableResource{
+				ID:     &name,
+				Schema: expandCosmosCassandraTableSchema(d.Get("schema").([]interface{})),
+			},
+			Options: map[string]*string{},
+		},
+	}
+
+	if defaultTTL, ok := d.GetOkExists("default_ttl"); ok {
+		db.CassandraTableCreateUpdateProperties.Resource.DefaultTTL = utils.Int32(int32(defaultTTL.(int)))
+	}
+
+	future, err := client.CreateUpdateCassandraTable(ctx, resourceGroup, account, keyspace, name, db)
+	if err != nil {
+		return fmt.Errorf("Error issuing create/update request for Cosmos Cassandra Table %s (Account %s, Keyspace %s): %+v", name, account, keyspace, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting on create/update future for Cosmos Cassandra Table %s (Account %s, Keyspace %s): %+v", name, account, keyspace, err)
+	}
+
+	throughputParameters := documentdb.ThroughputUpdateParameters{
+		ThroughputUpdateProperties: &documentdb.ThroughputUpdateProperties{
+			Resource: &documentdb.ThroughputResource{
+				Throughput: utils.Int32(int32(throughput)),
+			},
+		},
+	}
+
+	throughputFuture, err := client.UpdateCassandraTableThroughput(ctx, resourceGroup, account, keyspace, name, throughputParameters)
+	if err != nil {
+		return fmt.Errorf("Error setting Throughput for Cosmos Cassandra Table %s (Account %s, Keyspace %s): %+v", name, account, keyspace, err)
+	}
+
+	if err = throughputFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting on ThroughputUpdate future for Cosmos Cassandra Table %s (Account %s, Keyspace %s): %+v", name, account, keyspace, err)
+	}
+
+	resp, err := client.GetCassandraTable(ctx, resourceGroup, account, keyspace, name)
+	if err != nil {
+		return fmt.Errorf("Error making get request for Cosmos Cassandra Table %s (Account %s, Keyspace %s): %+v", name, account, keyspace, err)
+	}
+
+	id, err := azure.CosmosGetIDFromResponse(resp.Response)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the ID for Cosmos Cassandra Table %s (Account %s, Keyspace %s) ID: %v", name, account, keyspace, err)
+	}
+	d.SetId(id)
+
+	return resourceArmCosmosDbCassandraTableRead(d, meta)
+}
+
+func resourceArmCosmosDbCassandraTableRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosCassandraTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetCassandraTable(ctx, id.ResourceGroup, id.Account, id.Keyspace, id.Table)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Error reading Cosmos Cassandra Table %s (Account %s, Keyspace %s) - removing from state", id.Table, id.Account, id.Keyspace)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading Cosmos Cassandra Table %s (Account %s, Keyspace %s): %+v", id.Table, id.Account, id.Keyspace, err)
+	}
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.Account)
+	d.Set("cassandra_keyspace_name", id.Keyspace)
+	if props := resp.CassandraTableProperties; props != nil {
+		d.Set("name", props.ID)
+
+		if props.DefaultTTL != nil {
+			d.Set("default_ttl", int(*props.DefaultTTL))
+		}
+
+		if err := d.Set("schema", flattenCosmosCassandraTableSchema(props.Schema)); err != nil {
+			return fmt.Errorf("Error setting `schema`: %+v", err)
+		}
+	}
+
+	throughputResp, err := client.GetCassandraTableThroughput(ctx, id.ResourceGroup, id.Account, id.Keyspace, id.Table)
+	if err != nil {
+		return fmt.Errorf("Error reading Throughput on Cosmos Cassandra Table %s (Account %s, Keyspace %s): %+v", id.Table, id.Account, id.Keyspace, err)
+	}
+
+	if throughput := throughputResp.Throughput; throughput != nil {
+		d.Set("throughput", int(*throughput))
+	}
+
+	return nil
+}
+
+func resourceArmCosmosDbCassandraTableDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosCassandraTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeleteCassandraTable(ctx, id.ResourceGroup, id.Account, id.Keyspace, id.Table)
+	if err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error deleting Cosmos Cassandra Table %s (Account %s, Keyspace %s): %+v", id.Table, id.Account, id.Keyspace, err)
+		}
+	}
+
+	err = future.WaitForCompletionRef(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting on delete future for Cosmos Cassandra Table %s (Account %s, Keyspace %s): %+v", id.Table, id.Account, id.Keyspace, err)
+	}
+
+	return nil
+}
+
+func expandCosmosCassandraTableSchema(input []interface{}) *documentdb.CassandraSchema {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	block := input[0].(map[string]interface{})
+
+	columns := make([]documentdb.Column, 0)
+	for _, c := range block["column"].([]interface{}) {
+		column := c.(map[string]interface{})
+		columns = append(columns, documentdb.Column{
+			Name: utils.String(column["name"].(string)),
+			Type: utils.String(column["type"].(string)),
+		})
+	}
+
+	partitionKeys := make([]documentdb.CassandraPartitionKey, 0)
+	for _, p := range block["partition_key"].([]interface{}) {
+		partitionKey := p.(map[string]interface{})
+		partitionKeys = append(partitionKeys, documentdb.CassandraPartitionKey{
+			Name: utils.String(partitionKey["name"].(string)),
+		})
+	}
+
+	clusterKeys := make([]documentdb.ClusterKey, 0)
+	for _, c := range block["cluster_key"].([]interface{}) {
+		clusterKey := c.(map[string]interface{})
+		clusterKeys = append(clusterKeys, documentdb.ClusterKey{
+			Name:    utils.String(clusterKey["name"].(string)),
+			OrderBy: utils.String(clusterKey["order_by"].(string)),
+		})
+	}
+
+	return &documentdb.CassandraSchema{
+		Columns:       &columns,
+		PartitionKeys: &partitionKeys,
+		ClusterKeys:   &clusterKeys,
+	}
+}
+
+func flattenCosmosCassandraTableSchema(input *documentdb.CassandraSchema) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	columns := make([]interface{}, 0)
+	if input.Columns != nil {
+		for _, c := range *input.Columns {
+			name := ""
+			if c.Name != nil {
+				name = *c.Name
+			}
+			columnType := ""
+			if c.Type != nil {
+				columnType = *c.Type
+			}
+			columns = append(columns, map[string]interface{}{
+				"name": name,
+				"type": columnType,
+			})
+		}
+	}
+
+	partitionKeys := make([]interface{}, 0)
+	if input.PartitionKeys != nil {
+		for _, p := range *input.PartitionKeys {
+			name := ""
+			if p.Name != nil {
+				name = *p.Name
+			}
+			partitionKeys = append(partitionKeys, map[string]interface{}{
+				"name": name,
+			})
+		}
+	}
+
+	clusterKeys := make([]interface{}, 0)
+	if input.ClusterKeys != nil {
+		for _, c := range *input.ClusterKeys {
+			name := ""
+			if c.Name != nil {
+				name = *c.Name
+			}
+			orderBy := ""
+			if c.OrderBy != nil {
+				orderBy = *c.OrderBy
+			}
+			clusterKeys = append(clusterKeys, map[string]interface{}{
+				"name":     name,
+				"order_by": orderBy,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"column":        columns,
+			"partition_key": partitionKeys,
+			"cluster_key":   clusterKeys,
+		},
+	}
+}
@@ -0,0 +1,226 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCosmosDbGremlinGraph() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCosmosDbGremlinGraphCreateUpdate,
+		Read:   resourceArmCosmosDbGremlinGraphRead,
+		Update: resourceArmCosmosDbGremlinGraphCreateUpdate,
+		Delete: resourceArmCosmosDbGremlinGraphDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosEntityName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosAccountName,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosEntityName,
+			},
+
+			"partition_key_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"throughput": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      400,
+				ValidateFunc: validate.CosmosThroughput,
+			},
+		},
+	}
+}
+
+func resourceArmCosmosDbGremlinGraphCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	account := d.Get("account_name").(string)
+	database := d.Get("database_name").(string)
+	throughput := d.Get("throughput").(int)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.GetGremlinGraph(ctx, resourceGroup, account, database, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of creating Cosmos Gremlin Graph %s (Account %s, Database %s): %+v", name, account, database, err)
+			}
+		} else {
+			id, err := azure.CosmosGetIDFromResponse(existing.Response)
+			if err != nil {
+				return fmt.Errorf("Error generating import ID for Cosmos Gremlin Graph %s (Account %s, Database %s)", name, account, database)
+			}
+
+			return tf.ImportAsExistsError("azurerm_cosmosdb_gremlin_graph", id)
+		}
+	}
+
+	db := documentdb.GremlinGraphCreateUpdateParameters{
+		GremlinGraphCreateUpdateProperties: &documentdb.GremlinGraphCreateUpdateProperties{
+			Resource: &documentdb.GremlinGraphResource{
+				ID: &name,
+			},
+			Options: map[string]*string{},
+		},
+	}
+
+	if partitionKeyPath := d.Get("partition_key_path").(string); partitionKeyPath != "" {
+		db.GremlinGraphCreateUpdateProperties.Resource.PartitionKey = &documentdb.ContainerPartitionKey{
+			Paths: &[]string{partitionKeyPath},
+			Kind:  documentdb.PartitionKindHash,
+		}
+	}
+
+	future, err := client.CreateUpdateGremlinGraph(ctx, resourceGroup, account, database, name, db)
+	if err != nil {
+		return fmt.Errorf("Error issuing create/update request for Cosmos Gremlin Graph %s (Account %s, Database %s): %+v", name, account, database, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting on create/update future for Cosmos Gremlin Graph %s (Account %s, Database %s): %+v", name, account, database, err)
+	}
+
+	throughputParameters := documentdb.ThroughputUpdateParameters{
+		ThroughputUpdateProperties: &documentdb.ThroughputUpdateProperties{
+			Resource: &documentdb.ThroughputResource{
+				Throughput: utils.Int32(int32(throughput)),
+			},
+		},
+	}
+
+	throughputFuture, err := client.UpdateGremlinGraphThroughput(ctx, resourceGroup, account, database, name, throughputParameters)
+	if err != nil {
+		return fmt.Errorf("Error setting Throughput for Cosmos Gremlin Graph %s (Account %s, Database %s): %+v", name, account, database, err)
+	}
+
+	if err = throughputFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting on ThroughputUpdate future for Cosmos Gremlin Graph %s (Account %s, Database %s): %+v", name, account, database, err)
+	}
+
+	resp, err := client.GetGremlinGraph(ctx, resourceGroup, account, database, name)
+	if err != nil {
+		return fmt.Errorf("Error making get request for Cosmos Gremlin Graph %s (Account %s, Database %s): %+v", name, account, database, err)
+	}
+
+	id, err := azure.CosmosGetIDFromResponse(resp.Response)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the ID for Cosmos Gremlin Graph %s (Account %s, Database %s) ID: %v", name, account, database, err)
+	}
+	d.SetId(id)
+
+	return resourceArmCosmosDbGremlinGraphRead(d, meta)
+}
+
+func resourceArmCosmosDbGremlinGraphRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosGremlinGraphID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetGremlinGraph(ctx, id.ResourceGroup, id.Account, id.Database, id.Graph)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Error reading Cosmos Gremlin Graph %s (Account %s, Database %s) - removing from state", id.Graph, id.Account, id.Database)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading Cosmos Gremlin Graph %s (Account %s, Database %s): %+v", id.Graph, id.Account, id.Database, err)
+	}
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.Account)
+	d.Set("database_name", id.Database)
+	if props := resp.GremlinGraphProperties; props != nil {
+		d.Set("name", props.ID)
+
+		if pk := props.PartitionKey; pk != nil {
+			if paths := pk.Paths; paths != nil {
+				if len(*paths) > 1 {
+					return fmt.Errorf("Error reading PartitionKey Paths, more than 1 returned")
+				} else if len(*paths) == 1 {
+					d.Set("partition_key_path", (*paths)[0])
+				}
+			}
+		}
+	}
+
+	throughputResp, err := client.GetGremlinGraphThroughput(ctx, id.ResourceGroup, id.Account, id.Database, id.Graph)
+	if err != nil {
+		return fmt.Errorf("Error reading Throughput on Cosmos Gremlin Graph %s (Account %s, Database %s): %+v", id.Graph, id.Account, id.Database, err)
+	}
+
+	if throughput := throughputResp.Throughput; throughput != nil {
+		d.Set("throughput", int(*throughput))
+	}
+
+	return nil
+}
+
+func resourceArmCosmosDbGremlinGraphDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosGremlinGraphID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeleteGremlinGraph(ctx, id.ResourceGroup, id.Account, id.Database, id.Graph)
+	if err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error deleting Cosmos Gremlin Graph %s (Account %s, Database %s): %+v", id.Graph, id.Account, id.Database, err)
+		}
+	}
+
+	err = future.WaitForCompletionRef(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting on delete future for Cosmos Gremlin Graph %s (Account %s, Database %s): %+v", id.Graph, id.Account, id.Database, err)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -17,8 +18,9 @@ import (
 
 func resourceArmCosmosDbSQLContainer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmCosmosDbSQLContainerCreate,
+		Create: resourceArmCosmosDbSQLContainerCreateUpdate,
 		Read:   resourceArmCosmosDbSQLContainerRead,
+		Update: resourceArmCosmosDbSQLContainerCreateUpdate,
 		Delete: resourceArmCosmosDbSQLContainerDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -74,13 +76,135 @@ func resourceArmCosmosDbSQLContainer() *schema.Resource {
 					},
 				},
 			},
+
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+
+			"indexing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"indexing_mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(documentdb.Consistent),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(documentdb.Consistent),
+								string(documentdb.Lazy),
+								string(documentdb.None),
+							}, false),
+						},
+
+						"included_path": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"index": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"data_type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(documentdb.LineString),
+														string(documentdb.MultiPolygon),
+														string(documentdb.Number),
+														string(documentdb.Point),
+														string(documentdb.Polygon),
+														string(documentdb.String),
+													}, false),
+												},
+
+												"precision": {
+													Type:     schema.TypeInt,
+													Optional: true,
+													Default:  -1,
+												},
+
+												"kind": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(documentdb.Hash),
+														string(documentdb.Range),
+														string(documentdb.Spatial),
+													}, false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"excluded_path": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"conflict_resolution_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(documentdb.LastWriterWins),
+								string(documentdb.Custom),
+							}, false),
+						},
+
+						"conflict_resolution_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"conflict_resolution_procedure": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
-func resourceArmCosmosDbSQLContainerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceArmCosmosDbSQLContainerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).Cosmos.DatabaseClient
-	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
 	defer cancel()
 
 	name := d.Get("name").(string)
@@ -127,6 +251,18 @@ func resourceArmCosmosDbSQLContainerCreate(d *schema.ResourceData, meta interfac
 		}
 	}
 
+	if defaultTTL, ok := d.GetOkExists("default_ttl"); ok {
+		db.SQLContainerCreateUpdateProperties.Resource.DefaultTTL = utils.Int32(int32(defaultTTL.(int)))
+	}
+
+	if indexingPolicy := expandCosmosSQLContainerIndexingPolicy(d.Get("indexing_policy").([]interface{})); indexingPolicy != nil {
+		db.SQLContainerCreateUpdateProperties.Resource.IndexingPolicy = indexingPolicy
+	}
+
+	if conflictResolutionPolicy := expandCosmosSQLContainerConflictResolutionPolicy(d.Get("conflict_resolution_policy").([]interface{})); conflictResolutionPolicy != nil {
+		db.SQLContainerCreateUpdateProperties.Resource.ConflictResolutionPolicy = conflictResolutionPolicy
+	}
+
 	future, err := client.CreateUpdateSQLContainer(ctx, resourceGroup, account, database, name, db)
 	if err != nil {
 		return fmt.Errorf("Error issuing create/update request for Cosmos SQL Container %s (Account: %s, Database:%s): %+v", name, account, database, err)
@@ -192,6 +328,18 @@ func resourceArmCosmosDbSQLContainerRead(d *schema.ResourceData, meta interface{
 				return fmt.Errorf("Error setting `unique_key`: %+v", err)
 			}
 		}
+
+		if props.DefaultTTL != nil {
+			d.Set("default_ttl", int(*props.DefaultTTL))
+		}
+
+		if err := d.Set("indexing_policy", flattenCosmosSQLContainerIndexingPolicy(props.IndexingPolicy)); err != nil {
+			return fmt.Errorf("Error setting `indexing_policy`: %+v", err)
+		}
+
+		if err := d.Set("conflict_resolution_policy", flattenCosmosSQLContainerConflictResolutionPolicy(props.ConflictResolutionPolicy)); err != nil {
+			return fmt.Errorf("Error setting `conflict_resolution_policy`: %+v", err)
+		}
 	}
 
 	return nil
@@ -263,3 +411,152 @@ func flattenCosmosSQLContainerUniqueKeys(keys *[]documentdb.UniqueKey) *[]map[st
 
 	return &slice
 }
+
+func expandCosmosSQLContainerIndexingPolicy(input []interface{}) *documentdb.IndexingPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	block := input[0].(map[string]interface{})
+
+	policy := documentdb.IndexingPolicy{
+		Automatic:    utils.Bool(true),
+		IndexingMode: documentdb.IndexingMode(block["indexing_mode"].(string)),
+	}
+
+	includedPaths := make([]documentdb.IncludedPath, 0)
+	for _, v := range block["included_path"].([]interface{}) {
+		includedPath := v.(map[string]interface{})
+
+		path := documentdb.IncludedPath{
+			Path: utils.String(includedPath["path"].(string)),
+		}
+
+		indexes := make([]documentdb.Indexes, 0)
+		for _, i := range includedPath["index"].([]interface{}) {
+			index := i.(map[string]interface{})
+
+			indexes = append(indexes, documentdb.Indexes{
+				DataType:  documentdb.DataType(index["data_type"].(string)),
+				Precision: utils.Int32(int32(index["precision"].(int))),
+				Kind:      documentdb.IndexKind(index["kind"].(string)),
+			})
+		}
+
+		if len(indexes) > 0 {
+			path.Indexes = &indexes
+		}
+
+		includedPaths = append(includedPaths, path)
+	}
+	policy.IncludedPaths = &includedPaths
+
+	excludedPaths := make([]documentdb.ExcludedPath, 0)
+	for _, v := range block["excluded_path"].([]interface{}) {
+		excludedPath := v.(map[string]interface{})
+
+		excludedPaths = append(excludedPaths, documentdb.ExcludedPath{
+			Path: utils.String(excludedPath["path"].(string)),
+		})
+	}
+	policy.ExcludedPaths = &excludedPaths
+
+	return &policy
+}
+
+func flattenCosmosSQLContainerIndexingPolicy(policy *documentdb.IndexingPolicy) []interface{} {
+	if policy == nil {
+		return []interface{}{}
+	}
+
+	includedPaths := make([]interface{}, 0)
+	if policy.IncludedPaths != nil {
+		for _, p := range *policy.IncludedPaths {
+			indexes := make([]interface{}, 0)
+			if p.Indexes != nil {
+				for _, i := range *p.Indexes {
+					precision := 0
+					if i.Precision != nil {
+						precision = int(*i.Precision)
+					}
+
+					indexes = append(indexes, map[string]interface{}{
+						"data_type": string(i.DataType),
+						"precision": precision,
+						"kind":      string(i.Kind),
+					})
+				}
+			}
+
+			path := ""
+			if p.Path != nil {
+				path = *p.Path
+			}
+
+			includedPaths = append(includedPaths, map[string]interface{}{
+				"path":  path,
+				"index": indexes,
+			})
+		}
+	}
+
+	excludedPaths := make([]interface{}, 0)
+	if policy.ExcludedPaths != nil {
+		for _, p := range *policy.ExcludedPaths {
+			path := ""
+			if p.Path != nil {
+				path = *p.Path
+			}
+
+			excludedPaths = append(excludedPaths, map[string]interface{}{
+				"path": path,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"indexing_mode": string(policy.IndexingMode),
+			"included_path": includedPaths,
+			"excluded_path": excludedPaths,
+		},
+	}
+}
+
+func expandCosmosSQLContainerConflictResolutionPolicy(input []interface{}) *documentdb.ConflictResolutionPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	block := input[0].(map[string]interface{})
+
+	return &documentdb.ConflictResolutionPolicy{
+		Mode:                        documentdb.ConflictResolutionMode(block["mode"].(string)),
+		ConflictResolutionPath:      utils.String(block["conflict_resolution_path"].(string)),
+		ConflictResolutionProcedure: utils.String(block["conflict_resolution_procedure"].(string)),
+	}
+}
+
+func flattenCosmosSQLContainerConflictResolutionPolicy(policy *documentdb.ConflictResolutionPolicy) []interface{} {
+	if policy == nil {
+		return []interface{}{}
+	}
+
+	conflictResolutionPath := ""
+	if policy.ConflictResolutionPath != nil {
+		conflictResolutionPath = *policy.ConflictResolutionPath
+	}
+
+	conflictResolutionProcedure := ""
+	if policy.ConflictResolutionProcedure != nil {
+		conflictResolutionProcedure = *policy.ConflictResolutionProcedure
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":                          string(policy.Mode),
+			"conflict_resolution_path":      conflictResolutionPath,
+			"conflict_resolution_procedure": conflictResolutionProcedure,
+		},
+	}
+}
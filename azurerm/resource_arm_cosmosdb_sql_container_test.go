@@ -93,6 +93,31 @@ func TestAccAzureRMCosmosDbSqlContainer_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMCosmosDbSqlContainer_indexingPolicy(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_cosmosdb_sql_container.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbSqlContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+
+				Config: testAccAzureRMCosmosDbSqlContainer_indexingPolicy(ri, testLocation()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAzureRMCosmosDbSqlContainerExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMCosmosDbSqlContainerDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).Cosmos.DatabaseClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -163,6 +188,45 @@ resource "azurerm_cosmosdb_sql_container" "test" {
 }
 
 
+`, testAccAzureRMCosmosDbSqlDatabase_basic(rInt, location), rInt)
+}
+
+func testAccAzureRMCosmosDbSqlContainer_indexingPolicy(rInt int, location string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                = "acctest-CSQLC-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+  database_name       = "${azurerm_cosmosdb_sql_database.test.name}"
+  partition_key_path  = "/definition/id"
+  default_ttl         = 500
+
+  indexing_policy {
+    indexing_mode = "Consistent"
+
+    included_path {
+      path = "/*"
+
+      index {
+        data_type = "String"
+        precision = -1
+        kind      = "Range"
+      }
+    }
+
+    excluded_path {
+      path = "/excluded/*"
+    }
+  }
+
+  conflict_resolution_policy {
+    mode                          = "LastWriterWins"
+    conflict_resolution_path      = "/_ts"
+  }
+}
+
 `, testAccAzureRMCosmosDbSqlDatabase_basic(rInt, location), rInt)
 }
 
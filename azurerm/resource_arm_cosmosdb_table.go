@@ -17,8 +17,9 @@ import (
 
 func resourceArmCosmosDbTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmCosmosDbTableCreate,
+		Create: resourceArmCosmosDbTableCreateUpdate,
 		Read:   resourceArmCosmosDbTableRead,
+		Update: resourceArmCosmosDbTableCreateUpdate,
 		Delete: resourceArmCosmosDbTableDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -41,18 +42,26 @@ func resourceArmCosmosDbTable() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validate.CosmosAccountName,
 			},
+
+			"throughput": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      400,
+				ValidateFunc: validate.CosmosThroughput,
+			},
 		},
 	}
 }
 
-func resourceArmCosmosDbTableCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceArmCosmosDbTableCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).Cosmos.DatabaseClient
-	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
 	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 	account := d.Get("account_name").(string)
+	throughput := d.Get("throughput").(int)
 
 	if features.ShouldResourcesBeImported() && d.IsNewResource() {
 		existing, err := client.GetTable(ctx, resourceGroup, account, name)
@@ -88,6 +97,23 @@ func resourceArmCosmosDbTableCreate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error waiting on create/update future for Cosmos Table %s (Account %s): %+v", name, account, err)
 	}
 
+	throughputParameters := documentdb.ThroughputUpdateParameters{
+		ThroughputUpdateProperties: &documentdb.ThroughputUpdateProperties{
+			Resource: &documentdb.ThroughputResource{
+				Throughput: utils.Int32(int32(throughput)),
+			},
+		},
+	}
+
+	throughputFuture, err := client.UpdateTableThroughput(ctx, resourceGroup, account, name, throughputParameters)
+	if err != nil {
+		return fmt.Errorf("Error setting Throughput for Cosmos Table %s (Account %s): %+v", name, account, err)
+	}
+
+	if err = throughputFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting on ThroughputUpdate future for Cosmos Table %s (Account %s): %+v", name, account, err)
+	}
+
 	resp, err := client.GetTable(ctx, resourceGroup, account, name)
 	if err != nil {
 		return fmt.Errorf("Error making get request for Cosmos Table %s (Account %s): %+v", name, account, err)
@@ -129,6 +155,15 @@ func resourceArmCosmosDbTableRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("name", props.ID)
 	}
 
+	throughputResp, err := client.GetTableThroughput(ctx, id.ResourceGroup, id.Account, id.Table)
+	if err != nil {
+		return fmt.Errorf("Error reading Throughput on Cosmos Table %s (Account %s): %+v", id.Table, id.Account, err)
+	}
+
+	if throughput := throughputResp.Throughput; throughput != nil {
+		d.Set("throughput", int(*throughput))
+	}
+
 	return nil
 }
 
@@ -0,0 +1,387 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataFactoryIntegrationRuntimeManaged() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryIntegrationRuntimeManagedCreateUpdate,
+		Read:   resourceArmDataFactoryIntegrationRuntimeManagedRead,
+		Update: resourceArmDataFactoryIntegrationRuntimeManagedCreateUpdate,
+		Delete: resourceArmDataFactoryIntegrationRuntimeManagedDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryLinkedServiceDatasetName,
+			},
+
+			"data_factory_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`),
+					`Invalid name for Data Factory, see https://docs.microsoft.com/en-us/azure/data-factory/naming-rules`,
+				),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"location": azure.SchemaLocation(),
+
+			"node_size": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"number_of_nodes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+
+			"max_parallel_executions_per_node": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 16),
+			},
+
+			"edition": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(datafactory.Standard),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datafactory.Standard),
+					string(datafactory.Enterprise),
+				}, false),
+			},
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(datafactory.LicenseIncluded),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datafactory.LicenseIncluded),
+					string(datafactory.BasePrice),
+				}, false),
+			},
+
+			"vnet_integration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subnet_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"catalog_info": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server_endpoint": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"administrator_login": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"administrator_password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"pricing_tier": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(datafactory.IntegrationRuntimeSsisCatalogPricingTierBasic),
+								string(datafactory.IntegrationRuntimeSsisCatalogPricingTierStandard),
+								string(datafactory.IntegrationRuntimeSsisCatalogPricingTierPremium),
+								string(datafactory.IntegrationRuntimeSsisCatalogPricingTierPremiumRS),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmDataFactoryIntegrationRuntimeManagedCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.IntegrationRuntimeClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Data Factory Managed Integration Runtime %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_data_factory_integration_runtime_managed", *existing.ID)
+		}
+	}
+
+	description := d.Get("description").(string)
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	typeProperties := &datafactory.ManagedIntegrationRuntimeTypeProperties{
+		ComputeProperties: &datafactory.IntegrationRuntimeComputeProperties{
+			Location:                     &location,
+			NodeSize:                     utils.String(d.Get("node_size").(string)),
+			NumberOfNodes:                utils.Int32(int32(d.Get("number_of_nodes").(int))),
+			MaxParallelExecutionsPerNode: utils.Int32(int32(d.Get("max_parallel_executions_per_node").(int))),
+			VNetProperties:               expandDataFactoryIntegrationRuntimeManagedVNetIntegration(d.Get("vnet_integration").([]interface{})),
+		},
+		SsisProperties: &datafactory.IntegrationRuntimeSsisProperties{
+			Edition:     datafactory.IntegrationRuntimeEdition(d.Get("edition").(string)),
+			LicenseType: datafactory.IntegrationRuntimeLicenseType(d.Get("license_type").(string)),
+			CatalogInfo: expandDataFactoryIntegrationRuntimeManagedCatalogInfo(d.Get("catalog_info").([]interface{})),
+		},
+	}
+
+	managedIntegrationRuntime := &datafactory.ManagedIntegrationRuntime{
+		Description:                             &description,
+		Type:                                    datafactory.TypeManaged,
+		ManagedIntegrationRuntimeTypeProperties: typeProperties,
+	}
+
+	integrationRuntime := datafactory.IntegrationRuntimeResource{
+		Properties: managedIntegrationRuntime,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, dataFactoryName, name, integrationRuntime, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Data Factory Managed Integration Runtime %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Managed Integration Runtime %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Managed Integration Runtime %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDataFactoryIntegrationRuntimeManagedRead(d, meta)
+}
+
+func resourceArmDataFactoryIntegrationRuntimeManagedRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.IntegrationRuntimeClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["integrationruntimes"]
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Data Factory Managed Integration Runtime %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("data_factory_name", dataFactoryName)
+
+	managed, ok := resp.Properties.AsManagedIntegrationRuntime()
+	if !ok {
+		return fmt.Errorf("Error classifiying Data Factory Managed Integration Runtime %q (Data Factory %q / Resource Group %q): Expected: %q Received: %q", name, dataFactoryName, resourceGroup, datafactory.TypeManaged, *resp.Type)
+	}
+
+	if managed.Description != nil {
+		d.Set("description", managed.Description)
+	}
+
+	if props := managed.ManagedIntegrationRuntimeTypeProperties; props != nil {
+		if computeProps := props.ComputeProperties; computeProps != nil {
+			if computeProps.Location != nil {
+				d.Set("location", azure.NormalizeLocation(*computeProps.Location))
+			}
+			if computeProps.NodeSize != nil {
+				d.Set("node_size", computeProps.NodeSize)
+			}
+			if computeProps.NumberOfNodes != nil {
+				d.Set("number_of_nodes", computeProps.NumberOfNodes)
+			}
+			if computeProps.MaxParallelExecutionsPerNode != nil {
+				d.Set("max_parallel_executions_per_node", computeProps.MaxParallelExecutionsPerNode)
+			}
+			if err := d.Set("vnet_integration", flattenDataFactoryIntegrationRuntimeManagedVNetIntegration(computeProps.VNetProperties)); err != nil {
+				return fmt.Errorf("Error setting `vnet_integration`: %+v", err)
+			}
+		}
+
+		if ssisProps := props.SsisProperties; ssisProps != nil {
+			d.Set("edition", string(ssisProps.Edition))
+			d.Set("license_type", string(ssisProps.LicenseType))
+			if err := d.Set("catalog_info", flattenDataFactoryIntegrationRuntimeManagedCatalogInfo(d, ssisProps.CatalogInfo)); err != nil {
+				return fmt.Errorf("Error setting `catalog_info`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryIntegrationRuntimeManagedDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.IntegrationRuntimeClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["integrationruntimes"]
+
+	if _, err := client.Delete(ctx, resourceGroup, dataFactoryName, name); err != nil {
+		return fmt.Errorf("Error deleting Data Factory Managed Integration Runtime %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandDataFactoryIntegrationRuntimeManagedVNetIntegration(input []interface{}) *datafactory.IntegrationRuntimeVNetProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &datafactory.IntegrationRuntimeVNetProperties{
+		VNetID: utils.String(v["vnet_id"].(string)),
+		Subnet: utils.String(v["subnet_name"].(string)),
+	}
+}
+
+func flattenDataFactoryIntegrationRuntimeManagedVNetIntegration(input *datafactory.IntegrationRuntimeVNetProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	vnetID := ""
+	if input.VNetID != nil {
+		vnetID = *input.VNetID
+	}
+
+	subnetName := ""
+	if input.Subnet != nil {
+		subnetName = *input.Subnet
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"vnet_id":     vnetID,
+			"subnet_name": subnetName,
+		},
+	}
+}
+
+func expandDataFactoryIntegrationRuntimeManagedCatalogInfo(input []interface{}) *datafactory.IntegrationRuntimeSsisCatalogInfo {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	catalogInfo := &datafactory.IntegrationRuntimeSsisCatalogInfo{
+		CatalogServerEndpoint: utils.String(v["server_endpoint"].(string)),
+		CatalogPricingTier:    datafactory.IntegrationRuntimeSsisCatalogPricingTier(v["pricing_tier"].(string)),
+	}
+
+	if login := v["administrator_login"].(string); login != "" {
+		catalogInfo.CatalogAdminUserName = utils.String(login)
+	}
+
+	if password := v["administrator_password"].(string); password != "" {
+		catalogInfo.CatalogAdminPassword = &datafactory.SecureString{
+			Value: utils.String(password),
+			Type:  datafactory.TypeSecureString,
+		}
+	}
+
+	return catalogInfo
+}
+
+func flattenDataFactoryIntegrationRuntimeManagedCatalogInfo(d *schema.ResourceData, input *datafactory.IntegrationRuntimeSsisCatalogInfo) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	serverEndpoint := ""
+	if input.CatalogServerEndpoint != nil {
+		serverEndpoint = *input.CatalogServerEndpoint
+	}
+
+	adminUserName := ""
+	if input.CatalogAdminUserName != nil {
+		adminUserName = *input.CatalogAdminUserName
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"server_endpoint":        serverEndpoint,
+			"administrator_login":    adminUserName,
+			"administrator_password": d.Get("catalog_info.0.administrator_password").(string),
+			"pricing_tier":           string(input.CatalogPricingTier),
+		},
+	}
+}
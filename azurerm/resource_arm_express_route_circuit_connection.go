@@ -0,0 +1,209 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmExpressRouteCircuitConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmExpressRouteCircuitConnectionCreateUpdate,
+		Read:   resourceArmExpressRouteCircuitConnectionRead,
+		Update: resourceArmExpressRouteCircuitConnectionCreateUpdate,
+		Delete: resourceArmExpressRouteCircuitConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"peering_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"peer_peering_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"address_prefix_ipv4": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.CIDR,
+			},
+
+			"authorization_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func resourceArmExpressRouteCircuitConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.ExpressRouteCircuitConnectionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM ExpressRoute Circuit Connection creation.")
+
+	name := d.Get("name").(string)
+	peeringId := d.Get("peering_id").(string)
+
+	id, err := azure.ParseAzureResourceID(peeringId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	circuitName := id.Path["expressRouteCircuits"]
+	peeringName := id.Path["peerings"]
+
+	locks.ByName(circuitName, expressRouteCircuitResourceName)
+	defer locks.UnlockByName(circuitName, expressRouteCircuitResourceName)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, circuitName, peeringName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing ExpressRoute Circuit Connection %q (Circuit %q / Peering %q / Resource Group %q): %+v", name, circuitName, peeringName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_express_route_circuit_connection", *existing.ID)
+		}
+	}
+
+	connection := network.ExpressRouteCircuitConnection{
+		Name: &name,
+		ExpressRouteCircuitConnectionPropertiesFormat: &network.ExpressRouteCircuitConnectionPropertiesFormat{
+			ExpressRouteCircuitPeering: &network.SubResource{
+				ID: utils.String(peeringId),
+			},
+			PeerExpressRouteCircuitPeering: &network.SubResource{
+				ID: utils.String(d.Get("peer_peering_id").(string)),
+			},
+			AddressPrefix: utils.String(d.Get("address_prefix_ipv4").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("authorization_key"); ok {
+		connection.ExpressRouteCircuitConnectionPropertiesFormat.AuthorizationKey = utils.String(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, circuitName, peeringName, name, connection)
+	if err != nil {
+		return fmt.Errorf("Error Creating/Updating ExpressRoute Circuit Connection %q (Circuit %q / Peering %q / Resource Group %q): %+v", name, circuitName, peeringName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of ExpressRoute Circuit Connection %q (Circuit %q / Peering %q / Resource Group %q): %+v", name, circuitName, peeringName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, circuitName, peeringName, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ExpressRoute Circuit Connection %q (Circuit %q / Peering %q / Resource Group %q) ID", name, circuitName, peeringName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmExpressRouteCircuitConnectionRead(d, meta)
+}
+
+func resourceArmExpressRouteCircuitConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.ExpressRouteCircuitConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	circuitName := id.Path["expressRouteCircuits"]
+	peeringName := id.Path["peerings"]
+	name := id.Path["connections"]
+
+	resp, err := client.Get(ctx, resourceGroup, circuitName, peeringName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] ExpressRoute Circuit Connection %q does not exist - removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on ExpressRoute Circuit Connection %q (Circuit %q / Peering %q / Resource Group %q): %+v", name, circuitName, peeringName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+
+	if props := resp.ExpressRouteCircuitConnectionPropertiesFormat; props != nil {
+		if peering := props.ExpressRouteCircuitPeering; peering != nil {
+			d.Set("peering_id", peering.ID)
+		}
+		if peerPeering := props.PeerExpressRouteCircuitPeering; peerPeering != nil {
+			d.Set("peer_peering_id", peerPeering.ID)
+		}
+		d.Set("address_prefix_ipv4", props.AddressPrefix)
+		d.Set("authorization_key", props.AuthorizationKey)
+	}
+
+	return nil
+}
+
+func resourceArmExpressRouteCircuitConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.ExpressRouteCircuitConnectionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	circuitName := id.Path["expressRouteCircuits"]
+	peeringName := id.Path["peerings"]
+	name := id.Path["connections"]
+
+	locks.ByName(circuitName, expressRouteCircuitResourceName)
+	defer locks.UnlockByName(circuitName, expressRouteCircuitResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, circuitName, peeringName, name)
+	if err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error deleting ExpressRoute Circuit Connection %q (Circuit %q / Peering %q / Resource Group %q): %+v", name, circuitName, peeringName, resourceGroup, err)
+		}
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of ExpressRoute Circuit Connection %q (Circuit %q / Peering %q / Resource Group %q): %+v", name, circuitName, peeringName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -41,6 +41,10 @@ func TestAccAzureRMExpressRouteCircuit(t *testing.T) {
 			"multiple":       testAccAzureRMExpressRouteCircuitAuthorization_multiple,
 			"requiresImport": testAccAzureRMExpressRouteCircuitAuthorization_requiresImport,
 		},
+		"circuitConnection": {
+			"basic":          testAccAzureRMExpressRouteCircuitConnection_basic,
+			"requiresImport": testAccAzureRMExpressRouteCircuitConnection_requiresImport,
+		},
 	}
 
 	for group, m := range testCases {
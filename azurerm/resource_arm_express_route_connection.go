@@ -0,0 +1,210 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmExpressRouteConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmExpressRouteConnectionCreateUpdate,
+		Read:   resourceArmExpressRouteConnectionRead,
+		Update: resourceArmExpressRouteConnectionCreateUpdate,
+		Delete: resourceArmExpressRouteConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"express_route_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"express_route_circuit_peering_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"authorization_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"routing_weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 32000),
+			},
+		},
+	}
+}
+
+func resourceArmExpressRouteConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.ExpressRouteConnectionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM ExpressRoute Connection creation.")
+
+	name := d.Get("name").(string)
+	expressRouteGatewayId := d.Get("express_route_gateway_id").(string)
+
+	gatewayId, err := azure.ParseAzureResourceID(expressRouteGatewayId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := gatewayId.ResourceGroup
+	gatewayName := gatewayId.Path["expressRouteGateways"]
+
+	locks.ByName(gatewayName, expressRouteGatewayResourceName)
+	defer locks.UnlockByName(gatewayName, expressRouteGatewayResourceName)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, gatewayName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing ExpressRoute Connection %q (ExpressRoute Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_express_route_connection", *existing.ID)
+		}
+	}
+
+	connection := network.ExpressRouteConnection{
+		Name: &name,
+		ExpressRouteConnectionProperties: &network.ExpressRouteConnectionProperties{
+			ExpressRouteCircuitPeering: &network.ExpressRouteCircuitPeeringID{
+				ID: utils.String(d.Get("express_route_circuit_peering_id").(string)),
+			},
+			RoutingWeight: utils.Int32(int32(d.Get("routing_weight").(int))),
+		},
+	}
+
+	if v, ok := d.GetOk("authorization_key"); ok {
+		connection.ExpressRouteConnectionProperties.AuthorizationKey = utils.String(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, gatewayName, name, connection)
+	if err != nil {
+		return fmt.Errorf("Error Creating/Updating ExpressRoute Connection %q (ExpressRoute Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of ExpressRoute Connection %q (ExpressRoute Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, gatewayName, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ExpressRoute Connection %q (ExpressRoute Gateway %q / Resource Group %q) ID", name, gatewayName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmExpressRouteConnectionRead(d, meta)
+}
+
+func resourceArmExpressRouteConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.ExpressRouteConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	gatewayName := id.Path["expressRouteGateways"]
+	name := id.Path["expressRouteConnections"]
+
+	resp, err := client.Get(ctx, resourceGroup, gatewayName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] ExpressRoute Connection %q does not exist - removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on ExpressRoute Connection %q (ExpressRoute Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+
+	gatewaysClient := meta.(*ArmClient).Network.ExpressRouteGatewaysClient
+	gateway, err := gatewaysClient.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving ExpressRoute Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+	d.Set("express_route_gateway_id", gateway.ID)
+
+	if props := resp.ExpressRouteConnectionProperties; props != nil {
+		if peering := props.ExpressRouteCircuitPeering; peering != nil {
+			d.Set("express_route_circuit_peering_id", peering.ID)
+		}
+		d.Set("authorization_key", props.AuthorizationKey)
+		if weight := props.RoutingWeight; weight != nil {
+			d.Set("routing_weight", int(*weight))
+		}
+	}
+
+	return nil
+}
+
+func resourceArmExpressRouteConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.ExpressRouteConnectionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	gatewayName := id.Path["expressRouteGateways"]
+	name := id.Path["expressRouteConnections"]
+
+	locks.ByName(gatewayName, expressRouteGatewayResourceName)
+	defer locks.UnlockByName(gatewayName, expressRouteGatewayResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, gatewayName, name)
+	if err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error deleting ExpressRoute Connection %q (ExpressRoute Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+		}
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of ExpressRoute Connection %q (ExpressRoute Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	return nil
+}
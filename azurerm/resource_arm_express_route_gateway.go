@@ -0,0 +1,198 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var expressRouteGatewayResourceName = "azurerm_express_route_gateway"
+
+func resourceArmExpressRouteGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmExpressRouteGatewayCreateUpdate,
+		Read:   resourceArmExpressRouteGatewayRead,
+		Update: resourceArmExpressRouteGatewayCreateUpdate,
+		Delete: resourceArmExpressRouteGatewayDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"scale_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmExpressRouteGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.ExpressRouteGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM ExpressRoute Gateway creation.")
+
+	name := d.Get("name").(string)
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	resourceGroup := d.Get("resource_group_name").(string)
+	virtualHubId := d.Get("virtual_hub_id").(string)
+	scaleUnits := d.Get("scale_units").(int)
+	t := d.Get("tags").(map[string]interface{})
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_express_route_gateway", *existing.ID)
+		}
+	}
+
+	gateway := network.ExpressRouteGateway{
+		Name:     &name,
+		Location: &location,
+		ExpressRouteGatewayProperties: &network.ExpressRouteGatewayProperties{
+			VirtualHub: &network.VirtualHubID{
+				ID: utils.String(virtualHubId),
+			},
+			AutoScaleConfiguration: &network.ExpressRouteGatewayPropertiesAutoScaleConfiguration{
+				Bounds: &network.ExpressRouteGatewayPropertiesAutoScaleConfigurationBounds{
+					Min: utils.Int32(int32(scaleUnits)),
+					Max: utils.Int32(int32(scaleUnits)),
+				},
+			},
+		},
+		Tags: tags.Expand(t),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, gateway)
+	if err != nil {
+		return fmt.Errorf("Error Creating/Updating ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ExpressRoute Gateway %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmExpressRouteGatewayRead(d, meta)
+}
+
+func resourceArmExpressRouteGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.ExpressRouteGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["expressRouteGateways"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] ExpressRoute Gateway %q does not exist - removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.ExpressRouteGatewayProperties; props != nil {
+		if hub := props.VirtualHub; hub != nil {
+			d.Set("virtual_hub_id", hub.ID)
+		}
+
+		scaleUnits := 0
+		if config := props.AutoScaleConfiguration; config != nil {
+			if bounds := config.Bounds; bounds != nil && bounds.Min != nil {
+				scaleUnits = int(*bounds.Min)
+			}
+		}
+		d.Set("scale_units", scaleUnits)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmExpressRouteGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.ExpressRouteGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["expressRouteGateways"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error deleting ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
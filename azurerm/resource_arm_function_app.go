@@ -149,6 +149,8 @@ func resourceArmFunctionApp() *schema.Resource {
 				},
 			},
 
+			"storage_account": azure.SchemaAppServiceStorageAccounts(),
+
 			"tags": tags.Schema(),
 
 			"default_hostname": {
@@ -449,6 +451,17 @@ func resourceArmFunctionAppUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if d.HasChange("storage_account") {
+		storageAccounts := azure.ExpandAppServiceStorageAccounts(d)
+		properties := web.AzureStoragePropertyDictionaryResource{
+			Properties: storageAccounts,
+		}
+
+		if _, err := client.UpdateAzureStorageAccounts(ctx, resGroup, name, properties); err != nil {
+			return fmt.Errorf("Error updating Storage Accounts for Function App %q: %+v", name, err)
+		}
+	}
+
 	return resourceArmFunctionAppRead(d, meta)
 }
 
@@ -490,6 +503,11 @@ func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error making Read request on AzureRM Function App ConnectionStrings %q: %+v", name, err)
 	}
 
+	storageAccountsResp, err := client.ListAzureStorageAccounts(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM Function App AzureStorageAccounts %q: %+v", name, err)
+	}
+
 	siteCredFuture, err := client.ListPublishingCredentials(ctx, resGroup, name)
 	if err != nil {
 		return err
@@ -549,6 +567,9 @@ func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error
 	if err = d.Set("connection_string", flattenFunctionAppConnectionStrings(connectionStringsResp.Properties)); err != nil {
 		return err
 	}
+	if err = d.Set("storage_account", azure.FlattenAppServiceStorageAccounts(storageAccountsResp.Properties)); err != nil {
+		return err
+	}
 
 	configResp, err := client.GetConfiguration(ctx, resGroup, name)
 	if err != nil {
@@ -444,6 +444,21 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if meta.(*ArmClient).Features.KeyVault.PurgeSoftDeleteOnDestroy {
+		if props := read.Properties; props != nil {
+			softDeleteEnabled := props.EnableSoftDelete != nil && *props.EnableSoftDelete
+			purgeProtectionEnabled := props.EnablePurgeProtection != nil && *props.EnablePurgeProtection
+
+			if softDeleteEnabled && !purgeProtectionEnabled {
+				log.Printf("[DEBUG] Purging Key Vault %q (Resource Group %q)", name, resourceGroup)
+				if _, err := client.PurgeDeleted(ctx, name, *read.Location); err != nil {
+					return fmt.Errorf("Error purging Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+				}
+				log.Printf("[DEBUG] Purged Key Vault %q (Resource Group %q)", name, resourceGroup)
+			}
+		}
+	}
+
 	return nil
 }
 
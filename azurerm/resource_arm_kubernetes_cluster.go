@@ -67,6 +67,28 @@ func resourceArmKubernetesCluster() *schema.Resource {
 				return fmt.Errorf("`docker_bridge_cidr`, `dns_service_ip` and `service_cidr` should all be empty or all should be set.")
 			}
 
+			if v, exists := diff.GetOk("api_server_authorized_ip_ranges"); exists {
+				ranges := v.(*schema.Set)
+				if ranges.Len() == 0 {
+					return nil
+				}
+
+				loadBalancerSku := "basic"
+				if raw, ok := diff.GetOk("network_profile"); ok {
+					rawProfiles := raw.([]interface{})
+					if len(rawProfiles) > 0 {
+						profile := rawProfiles[0].(map[string]interface{})
+						if sku := profile["load_balancer_sku"].(string); sku != "" {
+							loadBalancerSku = sku
+						}
+					}
+				}
+
+				if !strings.EqualFold(loadBalancerSku, "standard") {
+					return fmt.Errorf("`api_server_authorized_ip_ranges` can only be used with a `standard` Load Balancer SKU")
+				}
+			}
+
 			return nil
 		},
 
@@ -211,6 +233,19 @@ func resourceArmKubernetesCluster() *schema.Resource {
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+
+						"orchestrator_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"enable_node_public_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
 					},
 				},
 			},
@@ -520,6 +555,33 @@ func resourceArmKubernetesCluster() *schema.Resource {
 				},
 			},
 
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerservice.SystemAssigned),
+								string(containerservice.None),
+							}, false),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 
 			"fqdn": {
@@ -674,6 +736,7 @@ func resourceArmKubernetesClusterCreate(d *schema.ResourceData, meta interface{}
 	servicePrincipalProfile := expandAzureRmKubernetesClusterServicePrincipal(d)
 	networkProfile := expandKubernetesClusterNetworkProfile(d)
 	addonProfiles := expandKubernetesClusterAddonProfiles(d)
+	identity := expandKubernetesClusterManagedClusterIdentity(d)
 
 	t := d.Get("tags").(map[string]interface{})
 
@@ -690,6 +753,7 @@ func resourceArmKubernetesClusterCreate(d *schema.ResourceData, meta interface{}
 	parameters := containerservice.ManagedCluster{
 		Name:     &name,
 		Location: &location,
+		Identity: identity,
 		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
 			APIServerAuthorizedIPRanges: apiServerAuthorizedIPRanges,
 			AadProfile:                  azureADProfile,
@@ -783,6 +847,7 @@ func resourceArmKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}
 	networkProfile := expandKubernetesClusterNetworkProfile(d)
 	servicePrincipalProfile := expandAzureRmKubernetesClusterServicePrincipal(d)
 	addonProfiles := expandKubernetesClusterAddonProfiles(d)
+	identity := expandKubernetesClusterManagedClusterIdentity(d)
 
 	t := d.Get("tags").(map[string]interface{})
 
@@ -796,15 +861,27 @@ func resourceArmKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}
 
 	enablePodSecurityPolicy := d.Get("enable_pod_security_policy").(bool)
 
+	// the control plane and each agent pool can independently pin a `kubernetes_version`/`orchestrator_version`,
+	// and node taints can be changed on an existing pool without recreating it - neither of these should be
+	// bundled into the ManagedCluster CreateOrUpdate below, so the control plane is updated first, and then
+	// each agent pool with a version or taint change is updated individually via the AgentPools API.
+	controlPlaneAgentProfiles := make([]containerservice.ManagedClusterAgentPoolProfile, len(agentProfiles))
+	copy(controlPlaneAgentProfiles, agentProfiles)
+	for i := range controlPlaneAgentProfiles {
+		controlPlaneAgentProfiles[i].OrchestratorVersion = nil
+		controlPlaneAgentProfiles[i].NodeTaints = nil
+	}
+
 	// TODO: should these values be conditionally updated?
 	parameters := containerservice.ManagedCluster{
 		Name:     &name,
 		Location: &location,
+		Identity: identity,
 		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
 			APIServerAuthorizedIPRanges: apiServerAuthorizedIPRanges,
 			AadProfile:                  azureADProfile,
 			AddonProfiles:               addonProfiles,
-			AgentPoolProfiles:           &agentProfiles,
+			AgentPoolProfiles:           &controlPlaneAgentProfiles,
 			DNSPrefix:                   utils.String(dnsPrefix),
 			EnableRBAC:                  utils.Bool(rbacEnabled),
 			KubernetesVersion:           utils.String(kubernetesVersion),
@@ -827,6 +904,50 @@ func resourceArmKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error waiting for update of Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	agentPoolsClient := meta.(*ArmClient).Containers.AgentPoolsClient
+	for _, agentProfile := range agentProfiles {
+		if agentProfile.Name == nil {
+			continue
+		}
+
+		// node taints (and the orchestrator version) can be changed on an existing pool without
+		// recreating it, so these are applied via the AgentPools API rather than being bundled into
+		// the ManagedCluster CreateOrUpdate above, which doesn't support mutating an existing pool's taints
+		if agentProfile.OrchestratorVersion == nil && agentProfile.NodeTaints == nil {
+			continue
+		}
+
+		agentPoolName := *agentProfile.Name
+		log.Printf("[DEBUG] Updating Agent Pool %q (Kubernetes Cluster %q / Resource Group %q)..", agentPoolName, name, resourceGroup)
+
+		agentPool := containerservice.AgentPool{
+			ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+				Count:               agentProfile.Count,
+				VMSize:              agentProfile.VMSize,
+				OsDiskSizeGB:        agentProfile.OsDiskSizeGB,
+				VnetSubnetID:        agentProfile.VnetSubnetID,
+				MaxPods:             agentProfile.MaxPods,
+				OsType:              agentProfile.OsType,
+				MaxCount:            agentProfile.MaxCount,
+				MinCount:            agentProfile.MinCount,
+				EnableAutoScaling:   agentProfile.EnableAutoScaling,
+				Type:                agentProfile.Type,
+				OrchestratorVersion: agentProfile.OrchestratorVersion,
+				AvailabilityZones:   agentProfile.AvailabilityZones,
+				NodeTaints:          agentProfile.NodeTaints,
+			},
+		}
+
+		agentPoolFuture, err := agentPoolsClient.CreateOrUpdate(ctx, resourceGroup, name, agentPoolName, agentPool)
+		if err != nil {
+			return fmt.Errorf("Error updating Agent Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", agentPoolName, name, resourceGroup, err)
+		}
+
+		if err = agentPoolFuture.WaitForCompletionRef(ctx, agentPoolsClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for update of Agent Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", agentPoolName, name, resourceGroup, err)
+		}
+	}
+
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -875,6 +996,11 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
+	identity := flattenKubernetesClusterManagedClusterIdentity(resp.Identity)
+	if err := d.Set("identity", identity); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
 	if props := resp.ManagedClusterProperties; props != nil {
 		d.Set("dns_prefix", props.DNSPrefix)
 		d.Set("fqdn", props.Fqdn)
@@ -1232,6 +1358,14 @@ func expandKubernetesClusterAgentPoolProfiles(d *schema.ResourceData) ([]contain
 			profile.NodeTaints = nodeTaints
 		}
 
+		if orchestratorVersion := config["orchestrator_version"].(string); orchestratorVersion != "" {
+			profile.OrchestratorVersion = utils.String(orchestratorVersion)
+		}
+
+		if enableNodePublicIP, ok := config["enable_node_public_ip"]; ok {
+			profile.EnableNodePublicIP = utils.Bool(enableNodePublicIP.(bool))
+		}
+
 		profiles = append(profiles, profile)
 	}
 
@@ -1292,20 +1426,32 @@ func flattenKubernetesClusterAgentPoolProfiles(profiles *[]containerservice.Mana
 			subnetId = *profile.VnetSubnetID
 		}
 
+		orchestratorVersion := ""
+		if profile.OrchestratorVersion != nil {
+			orchestratorVersion = *profile.OrchestratorVersion
+		}
+
+		enableNodePublicIP := false
+		if profile.EnableNodePublicIP != nil {
+			enableNodePublicIP = *profile.EnableNodePublicIP
+		}
+
 		agentPoolProfile := map[string]interface{}{
-			"availability_zones":  utils.FlattenStringSlice(profile.AvailabilityZones),
-			"count":               count,
-			"enable_auto_scaling": enableAutoScaling,
-			"max_count":           maxCount,
-			"max_pods":            maxPods,
-			"min_count":           minCount,
-			"name":                name,
-			"node_taints":         utils.FlattenStringSlice(profile.NodeTaints),
-			"os_disk_size_gb":     osDiskSizeGB,
-			"os_type":             string(profile.OsType),
-			"type":                string(profile.Type),
-			"vm_size":             string(profile.VMSize),
-			"vnet_subnet_id":      subnetId,
+			"availability_zones":    utils.FlattenStringSlice(profile.AvailabilityZones),
+			"count":                 count,
+			"enable_auto_scaling":   enableAutoScaling,
+			"enable_node_public_ip": enableNodePublicIP,
+			"max_count":             maxCount,
+			"max_pods":              maxPods,
+			"min_count":             minCount,
+			"name":                  name,
+			"node_taints":           utils.FlattenStringSlice(profile.NodeTaints),
+			"orchestrator_version":  orchestratorVersion,
+			"os_disk_size_gb":       osDiskSizeGB,
+			"os_type":               string(profile.OsType),
+			"type":                  string(profile.Type),
+			"vm_size":               string(profile.VMSize),
+			"vnet_subnet_id":        subnetId,
 
 			// TODO: remove in 2.0
 			"fqdn": fqdnVal,
@@ -1423,6 +1569,44 @@ func flattenKubernetesClusterWindowsProfile(profile *containerservice.ManagedClu
 	}
 }
 
+func expandKubernetesClusterManagedClusterIdentity(d *schema.ResourceData) *containerservice.ManagedClusterIdentity {
+	identities := d.Get("identity").([]interface{})
+	if len(identities) == 0 {
+		return nil
+	}
+
+	identity := identities[0].(map[string]interface{})
+	identityType := identity["type"].(string)
+
+	return &containerservice.ManagedClusterIdentity{
+		Type: containerservice.ResourceIdentityType(identityType),
+	}
+}
+
+func flattenKubernetesClusterManagedClusterIdentity(identity *containerservice.ManagedClusterIdentity) []interface{} {
+	if identity == nil {
+		return []interface{}{}
+	}
+
+	principalId := ""
+	if identity.PrincipalID != nil {
+		principalId = *identity.PrincipalID
+	}
+
+	tenantId := ""
+	if identity.TenantID != nil {
+		tenantId = *identity.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(identity.Type),
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
+
 func expandKubernetesClusterNetworkProfile(d *schema.ResourceData) *containerservice.NetworkProfileType {
 	configs := d.Get("network_profile").([]interface{})
 	if len(configs) == 0 {
@@ -0,0 +1,180 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmManagementGroupSubscriptionAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmManagementGroupSubscriptionAssociationCreate,
+		Read:   resourceArmManagementGroupSubscriptionAssociationRead,
+		Delete: resourceArmManagementGroupSubscriptionAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"management_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"subscription_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func resourceArmManagementGroupSubscriptionAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	groupsClient := meta.(*ArmClient).ManagementGroups.GroupsClient
+	subscriptionsClient := meta.(*ArmClient).ManagementGroups.SubscriptionClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	managementGroupId := d.Get("management_group_id").(string)
+	subscriptionId := d.Get("subscription_id").(string)
+
+	groupId, err := parseManagementGroupId(managementGroupId)
+	if err != nil {
+		return err
+	}
+
+	if features.ShouldResourcesBeImported() {
+		recurse := true
+		group, err := groupsClient.Get(ctx, groupId.groupId, "children", &recurse, "", managementGroupCacheControl)
+		if err != nil {
+			if !utils.ResponseWasNotFound(group.Response) {
+				return fmt.Errorf("Error checking for presence of existing Subscriptions associated with Management Group %q: %+v", groupId.groupId, err)
+			}
+		}
+
+		if props := group.Properties; props != nil {
+			if children := props.Children; children != nil {
+				for _, child := range *children {
+					if child.ID == nil {
+						continue
+					}
+
+					if strings.EqualFold(*child.ID, fmt.Sprintf("/subscriptions/%s", subscriptionId)) {
+						return tf.ImportAsExistsError("azurerm_management_group_subscription_association", fmt.Sprintf("%s|%s", managementGroupId, subscriptionId))
+					}
+				}
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Associating Subscription %q with Management Group %q..", subscriptionId, groupId.groupId)
+	if _, err := subscriptionsClient.Create(ctx, groupId.groupId, subscriptionId, managementGroupCacheControl); err != nil {
+		return fmt.Errorf("Error associating Subscription %q with Management Group %q: %+v", subscriptionId, groupId.groupId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", managementGroupId, subscriptionId))
+
+	return resourceArmManagementGroupSubscriptionAssociationRead(d, meta)
+}
+
+func resourceArmManagementGroupSubscriptionAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ManagementGroups.GroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	managementGroupId, subscriptionId, err := parseManagementGroupSubscriptionAssociationId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	groupId, err := parseManagementGroupId(managementGroupId)
+	if err != nil {
+		return err
+	}
+
+	recurse := true
+	group, err := client.Get(ctx, groupId.groupId, "children", &recurse, "", managementGroupCacheControl)
+	if err != nil {
+		if utils.ResponseWasNotFound(group.Response) {
+			log.Printf("[INFO] Management Group %q doesn't exist - removing Subscription Association from state", groupId.groupId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Management Group %q: %+v", groupId.groupId, err)
+	}
+
+	found := false
+	if props := group.Properties; props != nil {
+		if children := props.Children; children != nil {
+			for _, child := range *children {
+				if child.ID == nil {
+					continue
+				}
+
+				if strings.EqualFold(*child.ID, fmt.Sprintf("/subscriptions/%s", subscriptionId)) {
+					found = true
+					break
+				}
+			}
+		}
+	}
+
+	if !found {
+		log.Printf("[INFO] Subscription %q is no longer associated with Management Group %q - removing from state", subscriptionId, groupId.groupId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("management_group_id", managementGroupId)
+	d.Set("subscription_id", subscriptionId)
+
+	return nil
+}
+
+func resourceArmManagementGroupSubscriptionAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ManagementGroups.SubscriptionClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	managementGroupId, subscriptionId, err := parseManagementGroupSubscriptionAssociationId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	groupId, err := parseManagementGroupId(managementGroupId)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: whilst this says `Delete` it's actually `Deassociate` - which is /really/ helpful
+	resp, err := client.Delete(ctx, groupId.groupId, subscriptionId, managementGroupCacheControl)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error de-associating Subscription %q from Management Group %q: %+v", subscriptionId, groupId.groupId, err)
+		}
+	}
+
+	return nil
+}
+
+func parseManagementGroupSubscriptionAssociationId(input string) (string, string, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return "", "", fmt.Errorf("Expected ID to be in the format `{managementGroupId}|{subscriptionId}` but got %q", input)
+	}
+
+	return segments[0], segments[1], nil
+}
@@ -0,0 +1,127 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAzureRMManagementGroupSubscriptionAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_management_group_subscription_association.test"
+	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagementGroupSubscriptionAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAzureRMManagementGroupSubscriptionAssociation_basic(subscriptionID),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagementGroupSubscriptionAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMManagementGroupSubscriptionAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		managementGroupId, subscriptionId, err := parseManagementGroupSubscriptionAssociationId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		groupId, err := parseManagementGroupId(managementGroupId)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).ManagementGroups.GroupsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		recurse := true
+		resp, err := client.Get(ctx, groupId.groupId, "children", &recurse, "", managementGroupCacheControl)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on managementGroupsClient: %s", err)
+		}
+
+		if props := resp.Properties; props != nil {
+			if children := props.Children; children != nil {
+				for _, child := range *children {
+					if child.ID != nil && strings.EqualFold(*child.ID, fmt.Sprintf("/subscriptions/%s", subscriptionId)) {
+						return nil
+					}
+				}
+			}
+		}
+
+		return fmt.Errorf("Bad: Subscription %q is not associated with Management Group %q", subscriptionId, groupId.groupId)
+	}
+}
+
+func testCheckAzureRMManagementGroupSubscriptionAssociationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).ManagementGroups.GroupsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_management_group_subscription_association" {
+			continue
+		}
+
+		managementGroupId, subscriptionId, err := parseManagementGroupSubscriptionAssociationId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		groupId, err := parseManagementGroupId(managementGroupId)
+		if err != nil {
+			return err
+		}
+
+		recurse := true
+		resp, err := client.Get(ctx, groupId.groupId, "children", &recurse, "", managementGroupCacheControl)
+		if err != nil {
+			return nil
+		}
+
+		if props := resp.Properties; props != nil {
+			if children := props.Children; children != nil {
+				for _, child := range *children {
+					if child.ID != nil && strings.EqualFold(*child.ID, fmt.Sprintf("/subscriptions/%s", subscriptionId)) {
+						return fmt.Errorf("Subscription %q is still associated with Management Group %q", subscriptionId, groupId.groupId)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAzureRMManagementGroupSubscriptionAssociation_basic(subscriptionID string) string {
+	return fmt.Sprintf(`
+resource "azurerm_management_group" "test" {
+  display_name = "acctestmg-parent"
+}
+
+resource "azurerm_management_group_subscription_association" "test" {
+  management_group_id = "${azurerm_management_group.test.id}"
+  subscription_id      = "%s"
+}
+`, subscriptionID)
+}
@@ -6,8 +6,10 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2018-06-01/mariadb"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -176,11 +178,110 @@ func resourceArmMariaDbServer() *schema.Resource {
 				}, false),
 			},
 
+			"create_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(mariadb.CreateModeDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(mariadb.CreateModeDefault),
+					string(mariadb.CreateModeGeoRestore),
+					string(mariadb.CreateModePointInTimeRestore),
+					string(mariadb.CreateModeReplica),
+				}, false),
+			},
+
+			"creation_source_server_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"restore_point_in_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RFC3339Time,
+			},
+
+			"threat_detection_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disabled_alerts": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Sql_Injection",
+									"Sql_Injection_Vulnerability",
+									"Access_Anomaly",
+								}, true),
+							},
+						},
+
+						"email_account_admins": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"email_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"retention_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"state": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(mariadb.ServerSecurityAlertPolicyStateDisabled),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(mariadb.ServerSecurityAlertPolicyStateDisabled),
+								string(mariadb.ServerSecurityAlertPolicyStateEnabled),
+							}, false),
+						},
+
+						"storage_account_access_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"storage_endpoint": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
 			"fqdn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"replication_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -214,6 +315,7 @@ func resourceArmMariaDbServerCreateUpdate(d *schema.ResourceData, meta interface
 	adminLoginPassword := d.Get("administrator_login_password").(string)
 	sslEnforcement := d.Get("ssl_enforcement").(string)
 	version := d.Get("version").(string)
+	createMode := d.Get("create_mode").(string)
 	t := d.Get("tags").(map[string]interface{})
 
 	sku := expandAzureRmMariaDbServerSku(d)
@@ -271,18 +373,62 @@ func resourceArmMariaDbServerCreateUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	properties := mariadb.ServerForCreate{
-		Location: &location,
-		Properties: &mariadb.ServerPropertiesForDefaultCreate{
+	var serverProperties mariadb.BasicServerPropertiesForCreate
+	switch createMode {
+	case string(mariadb.CreateModePointInTimeRestore), string(mariadb.CreateModeGeoRestore), string(mariadb.CreateModeReplica):
+		sourceServerID, ok := d.GetOk("creation_source_server_id")
+		if !ok {
+			return fmt.Errorf("`creation_source_server_id` is required when `create_mode` is %q", createMode)
+		}
+
+		switch createMode {
+		case string(mariadb.CreateModePointInTimeRestore):
+			restorePointInTime, ok := d.GetOk("restore_point_in_time")
+			if !ok {
+				return fmt.Errorf("`restore_point_in_time` is required when `create_mode` is %q", createMode)
+			}
+
+			restorePointInTimeDate, err := date.ParseTime(time.RFC3339, restorePointInTime.(string))
+			if err != nil {
+				return fmt.Errorf("`restore_point_in_time` wasn't a valid RFC3339 date %q: %+v", restorePointInTime, err)
+			}
+
+			serverProperties = &mariadb.ServerPropertiesForRestore{
+				SourceServerID:     utils.String(sourceServerID.(string)),
+				RestorePointInTime: &date.Time{Time: restorePointInTimeDate},
+				Version:            mariadb.ServerVersion(version),
+				SslEnforcement:     mariadb.SslEnforcementEnum(sslEnforcement),
+				StorageProfile:     storageProfile,
+			}
+		case string(mariadb.CreateModeGeoRestore):
+			serverProperties = &mariadb.ServerPropertiesForGeoRestore{
+				SourceServerID: utils.String(sourceServerID.(string)),
+				Version:        mariadb.ServerVersion(version),
+				SslEnforcement: mariadb.SslEnforcementEnum(sslEnforcement),
+				StorageProfile: storageProfile,
+			}
+		case string(mariadb.CreateModeReplica):
+			serverProperties = &mariadb.ServerPropertiesForReplica{
+				SourceServerID: utils.String(sourceServerID.(string)),
+				Version:        mariadb.ServerVersion(version),
+			}
+		}
+	default:
+		serverProperties = &mariadb.ServerPropertiesForDefaultCreate{
 			AdministratorLogin:         utils.String(adminLogin),
 			AdministratorLoginPassword: utils.String(adminLoginPassword),
 			Version:                    mariadb.ServerVersion(version),
 			SslEnforcement:             mariadb.SslEnforcementEnum(sslEnforcement),
 			StorageProfile:             storageProfile,
 			CreateMode:                 mariadb.CreateModeDefault,
-		},
-		Sku:  sku,
-		Tags: tags.Expand(t),
+		}
+	}
+
+	properties := mariadb.ServerForCreate{
+		Location:   &location,
+		Properties: serverProperties,
+		Sku:        sku,
+		Tags:       tags.Expand(t),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, properties)
@@ -305,6 +451,21 @@ func resourceArmMariaDbServerCreateUpdate(d *schema.ResourceData, meta interface
 
 	d.SetId(*read.ID)
 
+	if d.HasChange("threat_detection_policy") {
+		threatDetectionPolicy := expandMariaDbThreatDetectionPolicy(d)
+		if threatDetectionPolicy != nil {
+			threatDetectionPolicyClient := meta.(*ArmClient).MariaDB.ServerSecurityAlertPoliciesClient
+			threatDetectionFuture, err := threatDetectionPolicyClient.CreateOrUpdate(ctx, resourceGroup, name, *threatDetectionPolicy)
+			if err != nil {
+				return fmt.Errorf("Error setting Threat Detection Policy for MariaDB Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+
+			if err = threatDetectionFuture.WaitForCompletionRef(ctx, threatDetectionPolicyClient.Client); err != nil {
+				return fmt.Errorf("Error waiting for Threat Detection Policy for MariaDB Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+	}
+
 	return resourceArmMariaDbServerRead(d, meta)
 }
 
@@ -344,6 +505,7 @@ func resourceArmMariaDbServerRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("ssl_enforcement", string(properties.SslEnforcement))
 		// Computed
 		d.Set("fqdn", properties.FullyQualifiedDomainName)
+		d.Set("replication_role", properties.ReplicationRole)
 
 		if err := d.Set("storage_profile", flattenMariaDbStorageProfile(properties.StorageProfile)); err != nil {
 			return fmt.Errorf("Error setting `storage_profile`: %+v", err)
@@ -354,6 +516,14 @@ func resourceArmMariaDbServerRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error setting `sku`: %+v", err)
 	}
 
+	threatDetectionPolicyClient := meta.(*ArmClient).MariaDB.ServerSecurityAlertPoliciesClient
+	threatDetectionPolicy, err := threatDetectionPolicyClient.Get(ctx, resourceGroup, name)
+	if err == nil {
+		if err := d.Set("threat_detection_policy", flattenMariaDbThreatDetectionPolicy(d, threatDetectionPolicy.SecurityAlertPolicyProperties)); err != nil {
+			return fmt.Errorf("Error setting `threat_detection_policy`: %+v", err)
+		}
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -468,3 +638,63 @@ func flattenMariaDbStorageProfile(storage *mariadb.StorageProfile) []interface{}
 
 	return []interface{}{values}
 }
+
+func expandMariaDbThreatDetectionPolicy(d *schema.ResourceData) *mariadb.ServerSecurityAlertPolicy {
+	policies := d.Get("threat_detection_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		return nil
+	}
+
+	policy := policies[0].(map[string]interface{})
+
+	properties := mariadb.SecurityAlertPolicyProperties{
+		State:              mariadb.ServerSecurityAlertPolicyState(policy["state"].(string)),
+		EmailAccountAdmins: utils.Bool(policy["email_account_admins"].(bool)),
+		DisabledAlerts:     utils.ExpandStringSlice(policy["disabled_alerts"].(*schema.Set).List()),
+		EmailAddresses:     utils.ExpandStringSlice(policy["email_addresses"].(*schema.Set).List()),
+		RetentionDays:      utils.Int32(int32(policy["retention_days"].(int))),
+	}
+
+	if v, ok := policy["storage_endpoint"]; ok && v.(string) != "" {
+		properties.StorageEndpoint = utils.String(v.(string))
+	}
+
+	if v, ok := policy["storage_account_access_key"]; ok && v.(string) != "" {
+		properties.StorageAccountAccessKey = utils.String(v.(string))
+	}
+
+	return &mariadb.ServerSecurityAlertPolicy{
+		SecurityAlertPolicyProperties: &properties,
+	}
+}
+
+func flattenMariaDbThreatDetectionPolicy(d *schema.ResourceData, properties *mariadb.SecurityAlertPolicyProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	policy := make(map[string]interface{})
+
+	policy["state"] = string(properties.State)
+	policy["disabled_alerts"] = utils.FlattenStringSlice(properties.DisabledAlerts)
+	policy["email_addresses"] = utils.FlattenStringSlice(properties.EmailAddresses)
+
+	if properties.EmailAccountAdmins != nil {
+		policy["email_account_admins"] = *properties.EmailAccountAdmins
+	}
+
+	if properties.StorageEndpoint != nil {
+		policy["storage_endpoint"] = *properties.StorageEndpoint
+	}
+
+	if properties.RetentionDays != nil {
+		policy["retention_days"] = int(*properties.RetentionDays)
+	}
+
+	// the API does not return the storage account access key for security reasons, so pull it through from state
+	if v, ok := d.GetOk("threat_detection_policy.0.storage_account_access_key"); ok {
+		policy["storage_account_access_key"] = v.(string)
+	}
+
+	return []interface{}{policy}
+}
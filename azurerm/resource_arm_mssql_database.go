@@ -0,0 +1,351 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMsSqlDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlDatabaseCreateUpdate,
+		Read:   resourceArmMsSqlDatabaseRead,
+		Update: resourceArmMsSqlDatabaseCreateUpdate,
+		Delete: resourceArmMsSqlDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlDatabaseName,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"sku_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"elastic_pool_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"collation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"max_size_gb": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.FloatAtLeast(0),
+			},
+
+			"min_capacity": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.FloatAtLeast(0),
+			},
+
+			"auto_pause_delay_in_minutes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"read_scale": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"zone_redundant": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.BasePrice),
+					string(sql.LicenseIncluded),
+				}, false),
+			},
+
+			"short_term_retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"retention_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(7, 35),
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmMsSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Mssql.DatabasesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for MsSql Database creation.")
+
+	name := d.Get("name").(string)
+	serverName := d.Get("server_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, serverName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing MsSql Database %q (MsSql Server %q / Resource Group %q): %s", name, serverName, resGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mssql_database", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	database := sql.Database{
+		Name:     &name,
+		Location: &location,
+		DatabaseProperties: &sql.DatabaseProperties{
+			ReadScale: sql.DatabaseReadScaleDisabled,
+		},
+		Tags: tags.Expand(t),
+	}
+
+	if v, ok := d.GetOk("sku_name"); ok {
+		database.Sku = &sql.Sku{
+			Name: utils.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("elastic_pool_id"); ok {
+		database.ElasticPoolID = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("collation"); ok {
+		database.Collation = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_size_gb"); ok {
+		database.MaxSizeBytes = utils.Int64(int64(v.(float64) * 1073741824))
+	}
+
+	if v, ok := d.GetOkExists("min_capacity"); ok {
+		database.MinCapacity = utils.Float(v.(float64))
+	}
+
+	if v, ok := d.GetOkExists("auto_pause_delay_in_minutes"); ok {
+		database.AutoPauseDelay = utils.Int32(int32(v.(int)))
+	}
+
+	if d.Get("read_scale").(bool) {
+		database.ReadScale = sql.DatabaseReadScaleEnabled
+	}
+
+	if v, ok := d.GetOkExists("zone_redundant"); ok {
+		database.ZoneRedundant = utils.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("license_type"); ok {
+		database.LicenseType = sql.DatabaseLicenseType(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, serverName, name, database)
+	if err != nil {
+		return fmt.Errorf("Error creating MsSql Database %q (MsSql Server %q / Resource Group %q): %+v", name, serverName, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of MsSql Database %q (MsSql Server %q / Resource Group %q): %+v", name, serverName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, serverName, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read MsSql Database %q (MsSql Server %q / Resource Group %q) ID", name, serverName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	if v, ok := d.GetOk("short_term_retention_policy"); ok {
+		retentionClient := meta.(*ArmClient).Mssql.BackupShortTermRetentionPoliciesClient
+		retentionDays := expandArmMsSqlDatabaseShortTermRetentionPolicy(v.([]interface{}))
+
+		retentionFuture, err := retentionClient.CreateOrUpdate(ctx, resGroup, serverName, name, sql.BackupShortTermRetentionPolicy{
+			BackupShortTermRetentionPolicyProperties: &sql.BackupShortTermRetentionPolicyProperties{
+				RetentionDays: retentionDays,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Error setting `short_term_retention_policy` for MsSql Database %q (MsSql Server %q / Resource Group %q): %+v", name, serverName, resGroup, err)
+		}
+
+		if err = retentionFuture.WaitForCompletionRef(ctx, retentionClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for `short_term_retention_policy` update for MsSql Database %q (MsSql Server %q / Resource Group %q): %+v", name, serverName, resGroup, err)
+		}
+	}
+
+	return resourceArmMsSqlDatabaseRead(d, meta)
+}
+
+func resourceArmMsSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Mssql.DatabasesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["databases"]
+
+	resp, err := client.Get(ctx, resGroup, serverName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] MsSql Database %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on MsSql Database %s: %s", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("server_name", serverName)
+
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku_name", sku.Name)
+	}
+
+	if props := resp.DatabaseProperties; props != nil {
+		d.Set("elastic_pool_id", props.ElasticPoolID)
+		d.Set("collation", props.Collation)
+		d.Set("zone_redundant", props.ZoneRedundant)
+		d.Set("license_type", string(props.LicenseType))
+		d.Set("auto_pause_delay_in_minutes", props.AutoPauseDelay)
+		d.Set("read_scale", props.ReadScale == sql.DatabaseReadScaleEnabled)
+
+		if props.MinCapacity != nil {
+			d.Set("min_capacity", props.MinCapacity)
+		}
+
+		if props.MaxSizeBytes != nil {
+			d.Set("max_size_gb", float64(*props.MaxSizeBytes/int64(1073741824)))
+		}
+	}
+
+	retentionClient := meta.(*ArmClient).Mssql.BackupShortTermRetentionPoliciesClient
+	retentionPolicy, err := retentionClient.Get(ctx, resGroup, serverName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(retentionPolicy.Response) {
+			return fmt.Errorf("Error reading `short_term_retention_policy` for MsSql Database %q (MsSql Server %q / Resource Group %q): %+v", name, serverName, resGroup, err)
+		}
+	} else {
+		if err := d.Set("short_term_retention_policy", flattenArmMsSqlDatabaseShortTermRetentionPolicy(retentionPolicy)); err != nil {
+			return fmt.Errorf("Error setting `short_term_retention_policy`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmMsSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Mssql.DatabasesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["databases"]
+
+	future, err := client.Delete(ctx, resGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting MsSql Database %q (MsSql Server %q / Resource Group %q): %+v", name, serverName, resGroup, err)
+	}
+
+	return future.WaitForCompletionRef(ctx, client.Client)
+}
+
+func expandArmMsSqlDatabaseShortTermRetentionPolicy(input []interface{}) *int32 {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	policy := input[0].(map[string]interface{})
+	return utils.Int32(int32(policy["retention_days"].(int)))
+}
+
+func flattenArmMsSqlDatabaseShortTermRetentionPolicy(resp sql.BackupShortTermRetentionPolicy) []interface{} {
+	if resp.BackupShortTermRetentionPolicyProperties == nil || resp.RetentionDays == nil || *resp.RetentionDays == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"retention_days": int(*resp.RetentionDays),
+		},
+	}
+}
@@ -0,0 +1,206 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestAccAzureRMMsSqlDatabase_basic(t *testing.T) {
+	resourceName := "azurerm_mssql_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlDatabase_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "sku_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "collation"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlDatabase_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mssql_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlDatabase_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMsSqlDatabase_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_mssql_database"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlDatabase_serverless(t *testing.T) {
+	resourceName := "azurerm_mssql_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlDatabase_serverless(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku_name", "GP_S_Gen5_1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_pause_delay_in_minutes", "60"),
+					resource.TestCheckResourceAttr(resourceName, "min_capacity", "0.5"),
+					resource.TestCheckResourceAttr(resourceName, "short_term_retention_policy.0.retention_days", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMsSqlDatabaseExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).Mssql.DatabasesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on mssqlDatabasesClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: MsSql Database %q on server: %q (resource group: %q) does not exist", databaseName, serverName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlDatabaseDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Mssql.DatabasesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_database" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("MsSql Database still exists:\n%#v", resp.DatabaseProperties)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMMsSqlDatabase_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMMsSqlDatabase_basic(rInt int, location string) string {
+	template := testAccAzureRMMsSqlDatabase_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database" "test" {
+  name        = "acctest-db-%d"
+  server_name = "${azurerm_sql_server.test.name}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMsSqlDatabase_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMMsSqlDatabase_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database" "import" {
+  name        = "${azurerm_mssql_database.test.name}"
+  server_name = "${azurerm_mssql_database.test.server_name}"
+}
+`, template)
+}
+
+func testAccAzureRMMsSqlDatabase_serverless(rInt int, location string) string {
+	template := testAccAzureRMMsSqlDatabase_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database" "test" {
+  name                         = "acctest-db-%d"
+  server_name                  = "${azurerm_sql_server.test.name}"
+  sku_name                     = "GP_S_Gen5_1"
+  auto_pause_delay_in_minutes  = 60
+  min_capacity                 = 0.5
+
+  short_term_retention_policy {
+    retention_days = 10
+  }
+}
+`, template, rInt)
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -184,11 +186,111 @@ func resourceArmMySqlServer() *schema.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
+			"create_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(mysql.CreateModeDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(mysql.CreateModeDefault),
+					string(mysql.CreateModeGeoRestore),
+					string(mysql.CreateModePointInTimeRestore),
+					string(mysql.CreateModeReplica),
+				}, false),
+			},
+
+			"creation_source_server_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"restore_point_in_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RFC3339Time,
+			},
+
+			"threat_detection_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disabled_alerts": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Sql_Injection",
+									"Sql_Injection_Vulnerability",
+									"Access_Anomaly",
+								}, true),
+							},
+						},
+
+						"email_account_admins": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"email_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"retention_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"state": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppress.CaseDifference,
+							Default:          string(mysql.ServerSecurityAlertPolicyStateDisabled),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(mysql.ServerSecurityAlertPolicyStateDisabled),
+								string(mysql.ServerSecurityAlertPolicyStateEnabled),
+							}, true),
+						},
+
+						"storage_account_access_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"storage_endpoint": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
 			"fqdn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"replication_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 
@@ -220,7 +322,7 @@ func resourceArmMySqlServerCreate(d *schema.ResourceData, meta interface{}) erro
 	adminLoginPassword := d.Get("administrator_login_password").(string)
 	sslEnforcement := d.Get("ssl_enforcement").(string)
 	version := d.Get("version").(string)
-	createMode := "Default"
+	createMode := d.Get("create_mode").(string)
 	t := d.Get("tags").(map[string]interface{})
 
 	if features.ShouldResourcesBeImported() && d.IsNewResource() {
@@ -239,18 +341,62 @@ func resourceArmMySqlServerCreate(d *schema.ResourceData, meta interface{}) erro
 	sku := expandMySQLServerSku(d)
 	storageProfile := expandMySQLStorageProfile(d)
 
-	properties := mysql.ServerForCreate{
-		Location: &location,
-		Properties: &mysql.ServerPropertiesForDefaultCreate{
+	var serverProperties mysql.BasicServerPropertiesForCreate
+	switch createMode {
+	case string(mysql.CreateModePointInTimeRestore), string(mysql.CreateModeGeoRestore), string(mysql.CreateModeReplica):
+		sourceServerID, ok := d.GetOk("creation_source_server_id")
+		if !ok {
+			return fmt.Errorf("`creation_source_server_id` is required when `create_mode` is %q", createMode)
+		}
+
+		switch createMode {
+		case string(mysql.CreateModePointInTimeRestore):
+			restorePointInTime, ok := d.GetOk("restore_point_in_time")
+			if !ok {
+				return fmt.Errorf("`restore_point_in_time` is required when `create_mode` is %q", createMode)
+			}
+
+			restorePointInTimeDate, err := date.ParseTime(time.RFC3339, restorePointInTime.(string))
+			if err != nil {
+				return fmt.Errorf("`restore_point_in_time` wasn't a valid RFC3339 date %q: %+v", restorePointInTime, err)
+			}
+
+			serverProperties = &mysql.ServerPropertiesForRestore{
+				SourceServerID:     utils.String(sourceServerID.(string)),
+				RestorePointInTime: &date.Time{Time: restorePointInTimeDate},
+				Version:            mysql.ServerVersion(version),
+				SslEnforcement:     mysql.SslEnforcementEnum(sslEnforcement),
+				StorageProfile:     storageProfile,
+			}
+		case string(mysql.CreateModeGeoRestore):
+			serverProperties = &mysql.ServerPropertiesForGeoRestore{
+				SourceServerID: utils.String(sourceServerID.(string)),
+				Version:        mysql.ServerVersion(version),
+				SslEnforcement: mysql.SslEnforcementEnum(sslEnforcement),
+				StorageProfile: storageProfile,
+			}
+		case string(mysql.CreateModeReplica):
+			serverProperties = &mysql.ServerPropertiesForReplica{
+				SourceServerID: utils.String(sourceServerID.(string)),
+				Version:        mysql.ServerVersion(version),
+			}
+		}
+	default:
+		serverProperties = &mysql.ServerPropertiesForDefaultCreate{
 			AdministratorLogin:         utils.String(adminLogin),
 			AdministratorLoginPassword: utils.String(adminLoginPassword),
 			Version:                    mysql.ServerVersion(version),
 			SslEnforcement:             mysql.SslEnforcementEnum(sslEnforcement),
 			StorageProfile:             storageProfile,
-			CreateMode:                 mysql.CreateMode(createMode),
-		},
-		Sku:  sku,
-		Tags: tags.Expand(t),
+			CreateMode:                 mysql.CreateModeDefault,
+		}
+	}
+
+	properties := mysql.ServerForCreate{
+		Location:   &location,
+		Properties: serverProperties,
+		Sku:        sku,
+		Tags:       tags.Expand(t),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, properties)
@@ -273,6 +419,19 @@ func resourceArmMySqlServerCreate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(*read.ID)
 
+	threatDetectionPolicy := expandMySQLThreatDetectionPolicy(d)
+	if threatDetectionPolicy != nil {
+		threatDetectionPolicyClient := meta.(*ArmClient).Mysql.ServerSecurityAlertPoliciesClient
+		threatDetectionFuture, err := threatDetectionPolicyClient.CreateOrUpdate(ctx, resourceGroup, name, *threatDetectionPolicy)
+		if err != nil {
+			return fmt.Errorf("Error setting Threat Detection Policy for MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err = threatDetectionFuture.WaitForCompletionRef(ctx, threatDetectionPolicyClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for Threat Detection Policy for MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
 	return resourceArmMySqlServerRead(d, meta)
 }
 
@@ -324,6 +483,21 @@ func resourceArmMySqlServerUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(*read.ID)
 
+	if d.HasChange("threat_detection_policy") {
+		threatDetectionPolicy := expandMySQLThreatDetectionPolicy(d)
+		if threatDetectionPolicy != nil {
+			threatDetectionPolicyClient := meta.(*ArmClient).Mysql.ServerSecurityAlertPoliciesClient
+			threatDetectionFuture, err := threatDetectionPolicyClient.CreateOrUpdate(ctx, resourceGroup, name, *threatDetectionPolicy)
+			if err != nil {
+				return fmt.Errorf("Error setting Threat Detection Policy for MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+
+			if err = threatDetectionFuture.WaitForCompletionRef(ctx, threatDetectionPolicyClient.Client); err != nil {
+				return fmt.Errorf("Error waiting for Threat Detection Policy for MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+	}
+
 	return resourceArmMySqlServerRead(d, meta)
 }
 
@@ -370,6 +544,15 @@ func resourceArmMySqlServerRead(d *schema.ResourceData, meta interface{}) error
 
 	// Computed
 	d.Set("fqdn", resp.FullyQualifiedDomainName)
+	d.Set("replication_role", resp.ReplicationRole)
+
+	threatDetectionPolicyClient := meta.(*ArmClient).Mysql.ServerSecurityAlertPoliciesClient
+	threatDetectionPolicy, err := threatDetectionPolicyClient.Get(ctx, resourceGroup, name)
+	if err == nil {
+		if err := d.Set("threat_detection_policy", flattenMySQLThreatDetectionPolicy(d, threatDetectionPolicy.SecurityAlertPolicyProperties)); err != nil {
+			return fmt.Errorf("Error setting `threat_detection_policy`: %+v", err)
+		}
+	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
 }
@@ -469,3 +652,63 @@ func flattenMySQLStorageProfile(resp *mysql.StorageProfile) []interface{} {
 
 	return []interface{}{values}
 }
+
+func expandMySQLThreatDetectionPolicy(d *schema.ResourceData) *mysql.ServerSecurityAlertPolicy {
+	policies := d.Get("threat_detection_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		return nil
+	}
+
+	policy := policies[0].(map[string]interface{})
+
+	properties := mysql.SecurityAlertPolicyProperties{
+		State:              mysql.ServerSecurityAlertPolicyState(policy["state"].(string)),
+		EmailAccountAdmins: utils.Bool(policy["email_account_admins"].(bool)),
+		DisabledAlerts:     utils.ExpandStringSlice(policy["disabled_alerts"].(*schema.Set).List()),
+		EmailAddresses:     utils.ExpandStringSlice(policy["email_addresses"].(*schema.Set).List()),
+		RetentionDays:      utils.Int32(int32(policy["retention_days"].(int))),
+	}
+
+	if v, ok := policy["storage_endpoint"]; ok && v.(string) != "" {
+		properties.StorageEndpoint = utils.String(v.(string))
+	}
+
+	if v, ok := policy["storage_account_access_key"]; ok && v.(string) != "" {
+		properties.StorageAccountAccessKey = utils.String(v.(string))
+	}
+
+	return &mysql.ServerSecurityAlertPolicy{
+		SecurityAlertPolicyProperties: &properties,
+	}
+}
+
+func flattenMySQLThreatDetectionPolicy(d *schema.ResourceData, properties *mysql.SecurityAlertPolicyProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	policy := make(map[string]interface{})
+
+	policy["state"] = string(properties.State)
+	policy["disabled_alerts"] = utils.FlattenStringSlice(properties.DisabledAlerts)
+	policy["email_addresses"] = utils.FlattenStringSlice(properties.EmailAddresses)
+
+	if properties.EmailAccountAdmins != nil {
+		policy["email_account_admins"] = *properties.EmailAccountAdmins
+	}
+
+	if properties.StorageEndpoint != nil {
+		policy["storage_endpoint"] = *properties.StorageEndpoint
+	}
+
+	if properties.RetentionDays != nil {
+		policy["retention_days"] = int(*properties.RetentionDays)
+	}
+
+	// the API does not return the storage account access key for security reasons, so pull it through from state
+	if v, ok := d.GetOk("threat_detection_policy.0.storage_account_access_key"); ok {
+		policy["storage_account_access_key"] = v.(string)
+	}
+
+	return []interface{}{policy}
+}
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
@@ -99,6 +100,70 @@ func TestAccAzureRMMySQLServer_basicFiveSeven(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMMySQLServer_pointInTimeRestore(t *testing.T) {
+	resourceName := "azurerm_mysql_server.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	preConfig := testAccAzureRMMySQLServer_basicFiveSeven(ri, location)
+	timeToRestore := time.Now().Add(15 * time.Minute)
+	formattedTime := timeToRestore.UTC().Format(time.RFC3339)
+	postConfig := testAccAzureRMMySQLServer_pointInTimeRestore(ri, formattedTime, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMySQLServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:                    preConfig,
+				PreventPostDestroyRefresh: true,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySQLServerExists(resourceName),
+				),
+			},
+			{
+				PreConfig: func() { time.Sleep(timeToRestore.Sub(time.Now().Add(-1 * time.Minute))) },
+				Config:    postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySQLServerExists(resourceName),
+					testCheckAzureRMMySQLServerExists("azurerm_mysql_server.test_restore"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMySQLServer_threatDetectionPolicy(t *testing.T) {
+	resourceName := "azurerm_mysql_server.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMMySQLServer_threatDetectionPolicy(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMySQLServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySQLServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.0.state", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.0.retention_days", "15"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"administrator_login_password", // not returned as sensitive
+					"threat_detection_policy.0.storage_account_access_key",
+				},
+			},
+		},
+	})
+}
+
 func TestAccAzureRMMySqlServer_generalPurpose(t *testing.T) {
 	resourceName := "azurerm_mysql_server.test"
 	ri := tf.AccRandTimeInt()
@@ -358,6 +423,39 @@ resource "azurerm_mysql_server" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMMySQLServer_pointInTimeRestore(rInt int, formattedTime string, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_server" "test_restore" {
+  name                = "acctestmysqlsvr-%d-restore"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "GP_Gen5_2"
+    capacity = 2
+    tier     = "GeneralPurpose"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb            = 51200
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  administrator_login_password = "H@Sh1CoR3!"
+  version                      = "5.7"
+  ssl_enforcement              = "Enabled"
+
+  create_mode               = "PointInTimeRestore"
+  creation_source_server_id = "${azurerm_mysql_server.test.id}"
+  restore_point_in_time     = "%s"
+}
+`, testAccAzureRMMySQLServer_basicFiveSeven(rInt, location), rInt, formattedTime)
+}
+
 func testAccAzureRMMySQLServer_basicFiveSeven(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -391,6 +489,54 @@ resource "azurerm_mysql_server" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMMySQLServer_threatDetectionPolicy(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_mysql_server" "test" {
+  name                = "acctestmysqlsvr-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "GP_Gen5_2"
+    capacity = 2
+    tier     = "GeneralPurpose"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb            = 51200
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  administrator_login          = "acctestun"
+  administrator_login_password = "H@Sh1CoR3!"
+  version                      = "5.7"
+  ssl_enforcement              = "Enabled"
+
+  threat_detection_policy {
+    state                      = "Enabled"
+    retention_days             = 15
+    storage_account_access_key = "${azurerm_storage_account.test.primary_access_key}"
+    storage_endpoint           = "${azurerm_storage_account.test.primary_blob_endpoint}"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
 func testAccAzureRMMySQLServer_requiresImport(rInt int, location string) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,229 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmNatGatewayPublicIpAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNatGatewayPublicIpAssociationCreate,
+		Read:   resourceArmNatGatewayPublicIpAssociationRead,
+		Delete: resourceArmNatGatewayPublicIpAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nat_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"public_ip_address_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceArmNatGatewayPublicIpAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.NatGatewaysClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for NAT Gateway <-> Public IP Address Association creation.")
+
+	natGatewayId := d.Get("nat_gateway_id").(string)
+	publicIpAddressId := d.Get("public_ip_address_id").(string)
+
+	id, err := networkSvc.ParseNatGatewayResourceID(natGatewayId)
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, natGatewayResourceName)
+	defer locks.UnlockByName(id.Name, natGatewayResourceName)
+
+	natGateway, err := client.Get(ctx, id.Base.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(natGateway.Response) {
+			return fmt.Errorf("NAT Gateway %q (Resource Group %q) was not found!", id.Name, id.Base.ResourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving NAT Gateway %q (Resource Group %q): %+v", id.Name, id.Base.ResourceGroup, err)
+	}
+
+	props := natGateway.NatGatewayPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for NAT Gateway %q (Resource Group %q)", id.Name, id.Base.ResourceGroup)
+	}
+
+	publicIpAddresses := make([]network.SubResource, 0)
+
+	resourceId := fmt.Sprintf("%s|%s", natGatewayId, publicIpAddressId)
+	if props.PublicIPAddresses != nil {
+		for _, existingPublicIPAddress := range *props.PublicIPAddresses {
+			if id := existingPublicIPAddress.ID; id != nil {
+				if *id == publicIpAddressId {
+					if features.ShouldResourcesBeImported() {
+						return tf.ImportAsExistsError("azurerm_nat_gateway_public_ip_association", resourceId)
+					}
+
+					continue
+				}
+
+				publicIpAddresses = append(publicIpAddresses, existingPublicIPAddress)
+			}
+		}
+	}
+
+	publicIpAddresses = append(publicIpAddresses, network.SubResource{
+		ID: utils.String(publicIpAddressId),
+	})
+	props.PublicIPAddresses = &publicIpAddresses
+
+	future, err := client.CreateOrUpdate(ctx, id.Base.ResourceGroup, id.Name, natGateway)
+	if err != nil {
+		return fmt.Errorf("Error updating Public IP Association for NAT Gateway %q (Resource Group %q): %+v", id.Name, id.Base.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Public IP Association for NAT Gateway %q (Resource Group %q): %+v", id.Name, id.Base.ResourceGroup, err)
+	}
+
+	d.SetId(resourceId)
+
+	return resourceArmNatGatewayPublicIpAssociationRead(d, meta)
+}
+
+func resourceArmNatGatewayPublicIpAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.NatGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	splitId := strings.Split(d.Id(), "|")
+	if len(splitId) != 2 {
+		return fmt.Errorf("Expected ID to be in the format {natGatewayId}|{publicIPAddressId} but got %q", d.Id())
+	}
+
+	natGatewayId, err := networkSvc.ParseNatGatewayResourceID(splitId[0])
+	if err != nil {
+		return err
+	}
+	publicIpAddressId := splitId[1]
+
+	natGateway, err := client.Get(ctx, natGatewayId.Base.ResourceGroup, natGatewayId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(natGateway.Response) {
+			log.Printf("[DEBUG] NAT Gateway %q (Resource Group %q) could not be found - removing from state!", natGatewayId.Name, natGatewayId.Base.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving NAT Gateway %q (Resource Group %q): %+v", natGatewayId.Name, natGatewayId.Base.ResourceGroup, err)
+	}
+
+	found := false
+	if props := natGateway.NatGatewayPropertiesFormat; props != nil {
+		if publicIpAddresses := props.PublicIPAddresses; publicIpAddresses != nil {
+			for _, publicIpAddress := range *publicIpAddresses {
+				if publicIpAddress.ID == nil {
+					continue
+				}
+
+				if *publicIpAddress.ID == publicIpAddressId {
+					found = true
+					break
+				}
+			}
+		}
+	}
+
+	if !found {
+		log.Printf("[DEBUG] Association between NAT Gateway %q (Resource Group %q) and Public IP %q was not found - removing from state!", natGatewayId.Name, natGatewayId.Base.ResourceGroup, publicIpAddressId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("nat_gateway_id", natGateway.ID)
+	d.Set("public_ip_address_id", publicIpAddressId)
+
+	return nil
+}
+
+func resourceArmNatGatewayPublicIpAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.NatGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	splitId := strings.Split(d.Id(), "|")
+	if len(splitId) != 2 {
+		return fmt.Errorf("Expected ID to be in the format {natGatewayId}|{publicIPAddressId} but got %q", d.Id())
+	}
+
+	natGatewayId, err := networkSvc.ParseNatGatewayResourceID(splitId[0])
+	if err != nil {
+		return err
+	}
+	publicIpAddressId := splitId[1]
+
+	locks.ByName(natGatewayId.Name, natGatewayResourceName)
+	defer locks.UnlockByName(natGatewayId.Name, natGatewayResourceName)
+
+	natGateway, err := client.Get(ctx, natGatewayId.Base.ResourceGroup, natGatewayId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(natGateway.Response) {
+			log.Printf("[DEBUG] NAT Gateway %q (Resource Group %q) could not be found - removing from state!", natGatewayId.Name, natGatewayId.Base.ResourceGroup)
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving NAT Gateway %q (Resource Group %q): %+v", natGatewayId.Name, natGatewayId.Base.ResourceGroup, err)
+	}
+
+	props := natGateway.NatGatewayPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for NAT Gateway %q (Resource Group %q)", natGatewayId.Name, natGatewayId.Base.ResourceGroup)
+	}
+
+	publicIpAddresses := make([]network.SubResource, 0)
+	if existing := props.PublicIPAddresses; existing != nil {
+		for _, publicIpAddress := range *existing {
+			if publicIpAddress.ID == nil {
+				continue
+			}
+
+			if *publicIpAddress.ID != publicIpAddressId {
+				publicIpAddresses = append(publicIpAddresses, publicIpAddress)
+			}
+		}
+	}
+	props.PublicIPAddresses = &publicIpAddresses
+
+	future, err := client.CreateOrUpdate(ctx, natGatewayId.Base.ResourceGroup, natGatewayId.Name, natGateway)
+	if err != nil {
+		return fmt.Errorf("Error removing Public IP Association for NAT Gateway %q (Resource Group %q): %+v", natGatewayId.Name, natGatewayId.Base.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of Public IP Association for NAT Gateway %q (Resource Group %q): %+v", natGatewayId.Name, natGatewayId.Base.ResourceGroup, err)
+	}
+
+	return nil
+}
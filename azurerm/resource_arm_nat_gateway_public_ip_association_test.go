@@ -0,0 +1,147 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMNatGatewayPublicIpAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_nat_gateway_public_ip_association.test"
+	rInt := tf.AccRandTimeInt()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// intentional as this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMNatGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNatGatewayPublicIpAssociation_basic(rInt, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNatGatewayPublicIpAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNatGatewayPublicIpAssociation_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_nat_gateway_public_ip_association.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// intentional as this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMNatGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNatGatewayPublicIpAssociation_basic(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNatGatewayPublicIpAssociationExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMNatGatewayPublicIpAssociation_requiresImport(rInt, location),
+				ExpectError: testRequiresImportError("azurerm_nat_gateway_public_ip_association"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNatGatewayPublicIpAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		natGatewayId := rs.Primary.Attributes["nat_gateway_id"]
+		parsedId, err := networkSvc.ParseNatGatewayResourceID(natGatewayId)
+		if err != nil {
+			return err
+		}
+
+		publicIpAddressId := rs.Primary.Attributes["public_ip_address_id"]
+
+		client := testAccProvider.Meta().(*ArmClient).Network.NatGatewaysClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, parsedId.Base.ResourceGroup, parsedId.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: NAT Gateway %q (Resource Group %q) does not exist", parsedId.Name, parsedId.Base.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on natGatewaysClient: %+v", err)
+		}
+
+		props := resp.NatGatewayPropertiesFormat
+		if props == nil || props.PublicIPAddresses == nil {
+			return fmt.Errorf("No Public IP Addresses associated with NAT Gateway %q (Resource Group %q)", parsedId.Name, parsedId.Base.ResourceGroup)
+		}
+
+		for _, publicIpAddress := range *props.PublicIPAddresses {
+			if publicIpAddress.ID != nil && *publicIpAddress.ID == publicIpAddressId {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("No Public IP Address association exists between NAT Gateway %q (Resource Group %q) and Public IP %q", parsedId.Name, parsedId.Base.ResourceGroup, publicIpAddressId)
+	}
+}
+
+func testAccAzureRMNatGatewayPublicIpAssociation_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctest-pip-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_nat_gateway" "test" {
+  name                = "acctest-natgateway-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_nat_gateway_public_ip_association" "test" {
+  nat_gateway_id       = "${azurerm_nat_gateway.test.id}"
+  public_ip_address_id = "${azurerm_public_ip.test.id}"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMNatGatewayPublicIpAssociation_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMNatGatewayPublicIpAssociation_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_nat_gateway_public_ip_association" "import" {
+  nat_gateway_id       = "${azurerm_nat_gateway_public_ip_association.test.nat_gateway_id}"
+  public_ip_address_id = "${azurerm_nat_gateway_public_ip_association.test.public_ip_address_id}"
+}
+`, template)
+}
@@ -0,0 +1,229 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	networkSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmNatGatewayPublicIpPrefixAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNatGatewayPublicIpPrefixAssociationCreate,
+		Read:   resourceArmNatGatewayPublicIpPrefixAssociationRead,
+		Delete: resourceArmNatGatewayPublicIpPrefixAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nat_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"public_ip_prefix_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceArmNatGatewayPublicIpPrefixAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.NatGatewaysClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for NAT Gateway <-> Public IP Prefix Association creation.")
+
+	natGatewayId := d.Get("nat_gateway_id").(string)
+	publicIpPrefixId := d.Get("public_ip_prefix_id").(string)
+
+	id, err := networkSvc.ParseNatGatewayResourceID(natGatewayId)
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, natGatewayResourceName)
+	defer locks.UnlockByName(id.Name, natGatewayResourceName)
+
+	natGateway, err := client.Get(ctx, id.Base.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(natGateway.Response) {
+			return fmt.Errorf("NAT Gateway %q (Resource Group %q) was not found!", id.Name, id.Base.ResourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving NAT Gateway %q (Resource Group %q): %+v", id.Name, id.Base.ResourceGroup, err)
+	}
+
+	props := natGateway.NatGatewayPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for NAT Gateway %q (Resource Group %q)", id.Name, id.Base.ResourceGroup)
+	}
+
+	publicIpPrefixes := make([]network.SubResource, 0)
+
+	resourceId := fmt.Sprintf("%s|%s", natGatewayId, publicIpPrefixId)
+	if props.PublicIPPrefixes != nil {
+		for _, existingPublicIPPrefix := range *props.PublicIPPrefixes {
+			if id := existingPublicIPPrefix.ID; id != nil {
+				if *id == publicIpPrefixId {
+					if features.ShouldResourcesBeImported() {
+						return tf.ImportAsExistsError("azurerm_nat_gateway_public_ip_prefix_association", resourceId)
+					}
+
+					continue
+				}
+
+				publicIpPrefixes = append(publicIpPrefixes, existingPublicIPPrefix)
+			}
+		}
+	}
+
+	publicIpPrefixes = append(publicIpPrefixes, network.SubResource{
+		ID: utils.String(publicIpPrefixId),
+	})
+	props.PublicIPPrefixes = &publicIpPrefixes
+
+	future, err := client.CreateOrUpdate(ctx, id.Base.ResourceGroup, id.Name, natGateway)
+	if err != nil {
+		return fmt.Errorf("Error updating Public IP Prefix Association for NAT Gateway %q (Resource Group %q): %+v", id.Name, id.Base.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Public IP Prefix Association for NAT Gateway %q (Resource Group %q): %+v", id.Name, id.Base.ResourceGroup, err)
+	}
+
+	d.SetId(resourceId)
+
+	return resourceArmNatGatewayPublicIpPrefixAssociationRead(d, meta)
+}
+
+func resourceArmNatGatewayPublicIpPrefixAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.NatGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	splitId := strings.Split(d.Id(), "|")
+	if len(splitId) != 2 {
+		return fmt.Errorf("Expected ID to be in the format {natGatewayId}|{publicIPPrefixId} but got %q", d.Id())
+	}
+
+	natGatewayId, err := networkSvc.ParseNatGatewayResourceID(splitId[0])
+	if err != nil {
+		return err
+	}
+	publicIpPrefixId := splitId[1]
+
+	natGateway, err := client.Get(ctx, natGatewayId.Base.ResourceGroup, natGatewayId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(natGateway.Response) {
+			log.Printf("[DEBUG] NAT Gateway %q (Resource Group %q) could not be found - removing from state!", natGatewayId.Name, natGatewayId.Base.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving NAT Gateway %q (Resource Group %q): %+v", natGatewayId.Name, natGatewayId.Base.ResourceGroup, err)
+	}
+
+	found := false
+	if props := natGateway.NatGatewayPropertiesFormat; props != nil {
+		if publicIpPrefixes := props.PublicIPPrefixes; publicIpPrefixes != nil {
+			for _, publicIpPrefix := range *publicIpPrefixes {
+				if publicIpPrefix.ID == nil {
+					continue
+				}
+
+				if *publicIpPrefix.ID == publicIpPrefixId {
+					found = true
+					break
+				}
+			}
+		}
+	}
+
+	if !found {
+		log.Printf("[DEBUG] Association between NAT Gateway %q (Resource Group %q) and Public IP Prefix %q was not found - removing from state!", natGatewayId.Name, natGatewayId.Base.ResourceGroup, publicIpPrefixId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("nat_gateway_id", natGateway.ID)
+	d.Set("public_ip_prefix_id", publicIpPrefixId)
+
+	return nil
+}
+
+func resourceArmNatGatewayPublicIpPrefixAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.NatGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	splitId := strings.Split(d.Id(), "|")
+	if len(splitId) != 2 {
+		return fmt.Errorf("Expected ID to be in the format {natGatewayId}|{publicIPPrefixId} but got %q", d.Id())
+	}
+
+	natGatewayId, err := networkSvc.ParseNatGatewayResourceID(splitId[0])
+	if err != nil {
+		return err
+	}
+	publicIpPrefixId := splitId[1]
+
+	locks.ByName(natGatewayId.Name, natGatewayResourceName)
+	defer locks.UnlockByName(natGatewayId.Name, natGatewayResourceName)
+
+	natGateway, err := client.Get(ctx, natGatewayId.Base.ResourceGroup, natGatewayId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(natGateway.Response) {
+			log.Printf("[DEBUG] NAT Gateway %q (Resource Group %q) could not be found - removing from state!", natGatewayId.Name, natGatewayId.Base.ResourceGroup)
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving NAT Gateway %q (Resource Group %q): %+v", natGatewayId.Name, natGatewayId.Base.ResourceGroup, err)
+	}
+
+	props := natGateway.NatGatewayPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for NAT Gateway %q (Resource Group %q)", natGatewayId.Name, natGatewayId.Base.ResourceGroup)
+	}
+
+	publicIpPrefixes := make([]network.SubResource, 0)
+	if existing := props.PublicIPPrefixes; existing != nil {
+		for _, publicIpPrefix := range *existing {
+			if publicIpPrefix.ID == nil {
+				continue
+			}
+
+			if *publicIpPrefix.ID != publicIpPrefixId {
+				publicIpPrefixes = append(publicIpPrefixes, publicIpPrefix)
+			}
+		}
+	}
+	props.PublicIPPrefixes = &publicIpPrefixes
+
+	future, err := client.CreateOrUpdate(ctx, natGatewayId.Base.ResourceGroup, natGatewayId.Name, natGateway)
+	if err != nil {
+		return fmt.Errorf("Error removing Public IP Prefix Association for NAT Gateway %q (Resource Group %q): %+v", natGatewayId.Name, natGatewayId.Base.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of Public IP Prefix Association for NAT Gateway %q (Resource Group %q): %+v", natGatewayId.Name, natGatewayId.Base.ResourceGroup, err)
+	}
+
+	return nil
+}
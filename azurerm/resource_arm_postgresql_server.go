@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -188,11 +190,111 @@ func resourceArmPostgreSQLServer() *schema.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
+			"create_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(postgresql.CreateModeDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(postgresql.CreateModeDefault),
+					string(postgresql.CreateModeGeoRestore),
+					string(postgresql.CreateModePointInTimeRestore),
+					string(postgresql.CreateModeReplica),
+				}, false),
+			},
+
+			"creation_source_server_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"restore_point_in_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RFC3339Time,
+			},
+
+			"threat_detection_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disabled_alerts": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Sql_Injection",
+									"Sql_Injection_Vulnerability",
+									"Access_Anomaly",
+								}, true),
+							},
+						},
+
+						"email_account_admins": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"email_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"retention_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"state": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppress.CaseDifference,
+							Default:          string(postgresql.ServerSecurityAlertPolicyStateDisabled),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(postgresql.ServerSecurityAlertPolicyStateDisabled),
+								string(postgresql.ServerSecurityAlertPolicyStateEnabled),
+							}, true),
+						},
+
+						"storage_account_access_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"storage_endpoint": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
 			"fqdn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"replication_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 
@@ -224,7 +326,7 @@ func resourceArmPostgreSQLServerCreate(d *schema.ResourceData, meta interface{})
 	adminLoginPassword := d.Get("administrator_login_password").(string)
 	sslEnforcement := d.Get("ssl_enforcement").(string)
 	version := d.Get("version").(string)
-	createMode := "Default"
+	createMode := d.Get("create_mode").(string)
 	t := d.Get("tags").(map[string]interface{})
 
 	if features.ShouldResourcesBeImported() {
@@ -243,18 +345,62 @@ func resourceArmPostgreSQLServerCreate(d *schema.ResourceData, meta interface{})
 	sku := expandAzureRmPostgreSQLServerSku(d)
 	storageProfile := expandAzureRmPostgreSQLStorageProfile(d)
 
-	properties := postgresql.ServerForCreate{
-		Location: &location,
-		Properties: &postgresql.ServerPropertiesForDefaultCreate{
+	var serverProperties postgresql.BasicServerPropertiesForCreate
+	switch createMode {
+	case string(postgresql.CreateModePointInTimeRestore), string(postgresql.CreateModeGeoRestore), string(postgresql.CreateModeReplica):
+		sourceServerID, ok := d.GetOk("creation_source_server_id")
+		if !ok {
+			return fmt.Errorf("`creation_source_server_id` is required when `create_mode` is %q", createMode)
+		}
+
+		switch createMode {
+		case string(postgresql.CreateModePointInTimeRestore):
+			restorePointInTime, ok := d.GetOk("restore_point_in_time")
+			if !ok {
+				return fmt.Errorf("`restore_point_in_time` is required when `create_mode` is %q", createMode)
+			}
+
+			restorePointInTimeDate, err := date.ParseTime(time.RFC3339, restorePointInTime.(string))
+			if err != nil {
+				return fmt.Errorf("`restore_point_in_time` wasn't a valid RFC3339 date %q: %+v", restorePointInTime, err)
+			}
+
+			serverProperties = &postgresql.ServerPropertiesForRestore{
+				SourceServerID:     utils.String(sourceServerID.(string)),
+				RestorePointInTime: &date.Time{Time: restorePointInTimeDate},
+				Version:            postgresql.ServerVersion(version),
+				SslEnforcement:     postgresql.SslEnforcementEnum(sslEnforcement),
+				StorageProfile:     storageProfile,
+			}
+		case string(postgresql.CreateModeGeoRestore):
+			serverProperties = &postgresql.ServerPropertiesForGeoRestore{
+				SourceServerID: utils.String(sourceServerID.(string)),
+				Version:        postgresql.ServerVersion(version),
+				SslEnforcement: postgresql.SslEnforcementEnum(sslEnforcement),
+				StorageProfile: storageProfile,
+			}
+		case string(postgresql.CreateModeReplica):
+			serverProperties = &postgresql.ServerPropertiesForReplica{
+				SourceServerID: utils.String(sourceServerID.(string)),
+				Version:        postgresql.ServerVersion(version),
+			}
+		}
+	default:
+		serverProperties = &postgresql.ServerPropertiesForDefaultCreate{
 			AdministratorLogin:         utils.String(adminLogin),
 			AdministratorLoginPassword: utils.String(adminLoginPassword),
 			Version:                    postgresql.ServerVersion(version),
 			SslEnforcement:             postgresql.SslEnforcementEnum(sslEnforcement),
 			StorageProfile:             storageProfile,
-			CreateMode:                 postgresql.CreateMode(createMode),
-		},
-		Sku:  sku,
-		Tags: tags.Expand(t),
+			CreateMode:                 postgresql.CreateModeDefault,
+		}
+	}
+
+	properties := postgresql.ServerForCreate{
+		Location:   &location,
+		Properties: serverProperties,
+		Sku:        sku,
+		Tags:       tags.Expand(t),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, properties)
@@ -277,6 +423,19 @@ func resourceArmPostgreSQLServerCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(*read.ID)
 
+	threatDetectionPolicy := expandPostgreSQLThreatDetectionPolicy(d)
+	if threatDetectionPolicy != nil {
+		threatDetectionPolicyClient := meta.(*ArmClient).Postgres.ServerSecurityAlertPoliciesClient
+		threatDetectionFuture, err := threatDetectionPolicyClient.CreateOrUpdate(ctx, resourceGroup, name, *threatDetectionPolicy)
+		if err != nil {
+			return fmt.Errorf("Error setting Threat Detection Policy for PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err = threatDetectionFuture.WaitForCompletionRef(ctx, threatDetectionPolicyClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for Threat Detection Policy for PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
 	return resourceArmPostgreSQLServerRead(d, meta)
 }
 
@@ -328,6 +487,21 @@ func resourceArmPostgreSQLServerUpdate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(*read.ID)
 
+	if d.HasChange("threat_detection_policy") {
+		threatDetectionPolicy := expandPostgreSQLThreatDetectionPolicy(d)
+		if threatDetectionPolicy != nil {
+			threatDetectionPolicyClient := meta.(*ArmClient).Postgres.ServerSecurityAlertPoliciesClient
+			threatDetectionFuture, err := threatDetectionPolicyClient.CreateOrUpdate(ctx, resourceGroup, name, *threatDetectionPolicy)
+			if err != nil {
+				return fmt.Errorf("Error setting Threat Detection Policy for PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+
+			if err = threatDetectionFuture.WaitForCompletionRef(ctx, threatDetectionPolicyClient.Client); err != nil {
+				return fmt.Errorf("Error waiting for Threat Detection Policy for PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+	}
+
 	return resourceArmPostgreSQLServerRead(d, meta)
 }
 
@@ -375,6 +549,15 @@ func resourceArmPostgreSQLServerRead(d *schema.ResourceData, meta interface{}) e
 
 	// Computed
 	d.Set("fqdn", resp.FullyQualifiedDomainName)
+	d.Set("replication_role", resp.ReplicationRole)
+
+	threatDetectionPolicyClient := meta.(*ArmClient).Postgres.ServerSecurityAlertPoliciesClient
+	threatDetectionPolicy, err := threatDetectionPolicyClient.Get(ctx, resourceGroup, name)
+	if err == nil {
+		if err := d.Set("threat_detection_policy", flattenPostgreSQLThreatDetectionPolicy(d, threatDetectionPolicy.SecurityAlertPolicyProperties)); err != nil {
+			return fmt.Errorf("Error setting `threat_detection_policy`: %+v", err)
+		}
+	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
 }
@@ -482,3 +665,63 @@ func flattenPostgreSQLStorageProfile(resp *postgresql.StorageProfile) []interfac
 
 	return []interface{}{values}
 }
+
+func expandPostgreSQLThreatDetectionPolicy(d *schema.ResourceData) *postgresql.ServerSecurityAlertPolicy {
+	policies := d.Get("threat_detection_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		return nil
+	}
+
+	policy := policies[0].(map[string]interface{})
+
+	properties := postgresql.SecurityAlertPolicyProperties{
+		State:              postgresql.ServerSecurityAlertPolicyState(policy["state"].(string)),
+		EmailAccountAdmins: utils.Bool(policy["email_account_admins"].(bool)),
+		DisabledAlerts:     utils.ExpandStringSlice(policy["disabled_alerts"].(*schema.Set).List()),
+		EmailAddresses:     utils.ExpandStringSlice(policy["email_addresses"].(*schema.Set).List()),
+		RetentionDays:      utils.Int32(int32(policy["retention_days"].(int))),
+	}
+
+	if v, ok := policy["storage_endpoint"]; ok && v.(string) != "" {
+		properties.StorageEndpoint = utils.String(v.(string))
+	}
+
+	if v, ok := policy["storage_account_access_key"]; ok && v.(string) != "" {
+		properties.StorageAccountAccessKey = utils.String(v.(string))
+	}
+
+	return &postgresql.ServerSecurityAlertPolicy{
+		SecurityAlertPolicyProperties: &properties,
+	}
+}
+
+func flattenPostgreSQLThreatDetectionPolicy(d *schema.ResourceData, properties *postgresql.SecurityAlertPolicyProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	policy := make(map[string]interface{})
+
+	policy["state"] = string(properties.State)
+	policy["disabled_alerts"] = utils.FlattenStringSlice(properties.DisabledAlerts)
+	policy["email_addresses"] = utils.FlattenStringSlice(properties.EmailAddresses)
+
+	if properties.EmailAccountAdmins != nil {
+		policy["email_account_admins"] = *properties.EmailAccountAdmins
+	}
+
+	if properties.StorageEndpoint != nil {
+		policy["storage_endpoint"] = *properties.StorageEndpoint
+	}
+
+	if properties.RetentionDays != nil {
+		policy["retention_days"] = int(*properties.RetentionDays)
+	}
+
+	// the API does not return the storage account access key for security reasons, so pull it through from state
+	if v, ok := d.GetOk("threat_detection_policy.0.storage_account_access_key"); ok {
+		policy["storage_account_access_key"] = v.(string)
+	}
+
+	return []interface{}{policy}
+}
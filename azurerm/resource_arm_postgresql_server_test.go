@@ -133,6 +133,37 @@ func TestAccAzureRMPostgreSQLServer_basicEleven(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMPostgreSQLServer_threatDetectionPolicy(t *testing.T) {
+	resourceName := "azurerm_postgresql_server.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMPostgreSQLServer_threatDetectionPolicy(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPostgreSQLServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPostgreSQLServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.0.state", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "threat_detection_policy.0.retention_days", "15"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"administrator_login_password", // not returned as sensitive
+					"threat_detection_policy.0.storage_account_access_key",
+				},
+			},
+		},
+	})
+}
+
 func TestAccAzureRMPostgreSQLServer_requiresImport(t *testing.T) {
 	if !features.ShouldResourcesBeImported() {
 		t.Skip("Skipping since resources aren't required to be imported")
@@ -467,6 +498,55 @@ func testAccAzureRMPostgreSQLServer_basicEleven(rInt int, location string) strin
 	return testAccAzureRMPostgreSQLServer_basic(rInt, location, "11")
 }
 
+func testAccAzureRMPostgreSQLServer_threatDetectionPolicy(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_postgresql_server" "test" {
+  name                = "acctestpsqlsvr-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "GP_Gen5_2"
+    capacity = 2
+    tier     = "GeneralPurpose"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb            = 51200
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+    auto_grow             = "Disabled"
+  }
+
+  administrator_login          = "acctestun"
+  administrator_login_password = "H@Sh1CoR3!"
+  version                      = "9.6"
+  ssl_enforcement              = "Enabled"
+
+  threat_detection_policy {
+    state                      = "Enabled"
+    retention_days             = 15
+    storage_account_access_key = "${azurerm_storage_account.test.primary_access_key}"
+    storage_endpoint           = "${azurerm_storage_account.test.primary_blob_endpoint}"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
 func testAccAzureRMPostgreSQLServer_requiresImport(rInt int, location string) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,247 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmRecoveryServicesProtectionPolicyFileShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRecoveryServicesProtectionPolicyFileShareCreateUpdate,
+		Read:   resourceArmRecoveryServicesProtectionPolicyFileShareRead,
+		Update: resourceArmRecoveryServicesProtectionPolicyFileShareCreateUpdate,
+		Delete: resourceArmRecoveryServicesProtectionPolicyFileShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-_!a-zA-Z0-9]{2,149}$"),
+					"Backup Policy name must be 3 - 150 characters long, start with a letter, contain only letters and numbers.",
+				),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateRecoveryServicesVaultName,
+			},
+
+			"timezone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "UTC",
+			},
+
+			"backup": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+
+						"frequency": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(backup.ScheduleRunTypeDaily),
+							}, false),
+						},
+
+						"time": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile("^([01][0-9]|[2][0-3]):([03][0])$"), //time must be on the hour or half past
+								"Time of day must match the format HH:mm where HH is 00-23 and mm is 00 or 30",
+							),
+						},
+					},
+				},
+			},
+
+			"retention_daily": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 180),
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmRecoveryServicesProtectionPolicyFileShareCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).RecoveryServices.ProtectionPoliciesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	policyName := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	t := d.Get("tags").(map[string]interface{})
+
+	log.Printf("[DEBUG] Creating/updating Recovery Service File Share Protection Policy %s (resource group %q)", policyName, resourceGroup)
+
+	timeOfDay := d.Get("backup.0.time").(string)
+	dateOfDay, err := time.Parse(time.RFC3339, fmt.Sprintf("2018-07-30T%s:00Z", timeOfDay))
+	if err != nil {
+		return fmt.Errorf("Error generating time from %q for policy %q (Resource Group %q): %+v", timeOfDay, policyName, resourceGroup, err)
+	}
+	times := append(make([]date.Time, 0), date.Time{Time: dateOfDay})
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err2 := client.Get(ctx, vaultName, resourceGroup, policyName)
+		if err2 != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Recovery Service File Share Protection Policy %q (Resource Group %q): %+v", policyName, resourceGroup, err2)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_recovery_services_protection_policy_file_share", *existing.ID)
+		}
+	}
+
+	policy := backup.ProtectionPolicyResource{
+		Tags: tags.Expand(t),
+		Properties: &backup.AzureFileShareProtectionPolicy{
+			WorkLoadType:         backup.WorkloadTypeAzureFileShare,
+			BackupManagementType: backup.BackupManagementTypeAzureStorage,
+			TimeZone:             utils.String(d.Get("timezone").(string)),
+			SchedulePolicy:       expandArmRecoveryServicesProtectionPolicySchedule(d, times),
+			RetentionPolicy: &backup.LongTermRetentionPolicy{
+				RetentionPolicyType: backup.RetentionPolicyTypeLongTermRetentionPolicy,
+				DailySchedule:       expandArmRecoveryServicesProtectionPolicyRetentionDaily(d, times),
+			},
+		},
+	}
+
+	if _, err = client.CreateOrUpdate(ctx, vaultName, resourceGroup, policyName, policy); err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Service File Share Protection Policy %q (Resource Group %q): %+v", policyName, resourceGroup, err)
+	}
+
+	resp, err := resourceArmRecoveryServicesProtectionPolicyWaitForState(client, ctx, true, vaultName, resourceGroup, policyName)
+	if err != nil {
+		return err
+	}
+
+	id := strings.Replace(*resp.ID, "Subscriptions", "subscriptions", 1)
+	d.SetId(id)
+
+	return resourceArmRecoveryServicesProtectionPolicyFileShareRead(d, meta)
+}
+
+func resourceArmRecoveryServicesProtectionPolicyFileShareRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).RecoveryServices.ProtectionPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyName := id.Path["backupPolicies"]
+	vaultName := id.Path["vaults"]
+	resourceGroup := id.ResourceGroup
+
+	log.Printf("[DEBUG] Reading Recovery Service File Share Protection Policy %q (resource group %q)", policyName, resourceGroup)
+
+	resp, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Recovery Service File Share Protection Policy %q (Resource Group %q): %+v", policyName, resourceGroup, err)
+	}
+
+	d.Set("name", policyName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vaultName)
+
+	if properties, ok := resp.Properties.AsAzureFileShareProtectionPolicy(); ok && properties != nil {
+		d.Set("timezone", properties.TimeZone)
+
+		if schedule, ok := properties.SchedulePolicy.AsSimpleSchedulePolicy(); ok && schedule != nil {
+			if err := d.Set("backup", flattenArmRecoveryServicesProtectionPolicySchedule(schedule)); err != nil {
+				return fmt.Errorf("Error setting `backup`: %+v", err)
+			}
+		}
+
+		if retention, ok := properties.RetentionPolicy.AsLongTermRetentionPolicy(); ok && retention != nil {
+			if s := retention.DailySchedule; s != nil {
+				if err := d.Set("retention_daily", flattenArmRecoveryServicesProtectionPolicyRetentionDaily(s)); err != nil {
+					return fmt.Errorf("Error setting `retention_daily`: %+v", err)
+				}
+			}
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmRecoveryServicesProtectionPolicyFileShareDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).RecoveryServices.ProtectionPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyName := id.Path["backupPolicies"]
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+
+	log.Printf("[DEBUG] Deleting Recovery Service File Share Protection Policy %q (resource group %q)", policyName, resourceGroup)
+
+	resp, err := client.Delete(ctx, vaultName, resourceGroup, policyName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error issuing delete request for Recovery Service File Share Protection Policy %q (Resource Group %q): %+v", policyName, resourceGroup, err)
+		}
+	}
+
+	if _, err := resourceArmRecoveryServicesProtectionPolicyWaitForState(client, ctx, false, vaultName, resourceGroup, policyName); err != nil {
+		return err
+	}
+
+	return nil
+}
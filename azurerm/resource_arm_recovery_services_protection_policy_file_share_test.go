@@ -0,0 +1,163 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRecoveryServicesProtectionPolicyFileShare_basicDaily(t *testing.T) {
+	resourceName := "azurerm_recovery_services_protection_policy_file_share.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesProtectionPolicyFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesProtectionPolicyFileShare_basicDaily(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesProtectionPolicyFileShareExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.frequency", "Daily"),
+					resource.TestCheckResourceAttr(resourceName, "retention_daily.0.count", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRecoveryServicesProtectionPolicyFileShare_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_recovery_services_protection_policy_file_share.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesProtectionPolicyFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesProtectionPolicyFileShare_basicDaily(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesProtectionPolicyFileShareExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMRecoveryServicesProtectionPolicyFileShare_requiresImport(ri, testLocation()),
+				ExpectError: testRequiresImportError("azurerm_recovery_services_protection_policy_file_share"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRecoveryServicesProtectionPolicyFileShareDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).RecoveryServices.ProtectionPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_recovery_services_protection_policy_file_share" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		vaultName := rs.Primary.Attributes["recovery_vault_name"]
+		policyName := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Recovery Services File Share Protection Policy still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMRecoveryServicesProtectionPolicyFileShareExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).RecoveryServices.ProtectionPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		vaultName := rs.Primary.Attributes["recovery_vault_name"]
+		policyName := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on recoveryServicesPolicyClient: %+v", err)
+		}
+
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Bad: Recovery Services File Share Protection Policy %q (resource group: %q) does not exist", policyName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMRecoveryServicesProtectionPolicyFileShare_basicDaily(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_recovery_services_protection_policy_file_share" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+
+  backup {
+    frequency = "Daily"
+    time      = "23:00"
+  }
+
+  retention_daily {
+    count = 10
+  }
+}
+`, testAccAzureRMRecoveryServicesProtectionPolicyVm_base(rInt, location), rInt)
+}
+
+func testAccAzureRMRecoveryServicesProtectionPolicyFileShare_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_recovery_services_protection_policy_file_share" "import" {
+  name                = "${azurerm_recovery_services_protection_policy_file_share.test.name}"
+  resource_group_name = "${azurerm_recovery_services_protection_policy_file_share.test.resource_group_name}"
+  recovery_vault_name = "${azurerm_recovery_services_protection_policy_file_share.test.recovery_vault_name}"
+
+  backup {
+    frequency = "Daily"
+    time      = "23:00"
+  }
+
+  retention_daily {
+    count = 10
+  }
+}
+`, testAccAzureRMRecoveryServicesProtectionPolicyFileShare_basicDaily(rInt, location))
+}
@@ -0,0 +1,388 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2018-01-10/siterecovery"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmRecoveryServicesReplicationRecoveryPlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRecoveryServicesReplicationRecoveryPlanCreate,
+		Read:   resourceArmRecoveryServicesReplicationRecoveryPlanRead,
+		Update: resourceArmRecoveryServicesReplicationRecoveryPlanUpdate,
+		Delete: resourceArmRecoveryServicesReplicationRecoveryPlanDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateRecoveryServicesVaultName,
+			},
+			"source_recovery_fabric_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			"target_recovery_fabric_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			"recovery_plan_group": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(siterecovery.Boot),
+								string(siterecovery.Failover),
+								string(siterecovery.Shutdown),
+							}, false),
+						},
+						"replicated_protected_items": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+						},
+						"pre_action":  recoveryPlanActionSchema(),
+						"post_action": recoveryPlanActionSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func recoveryPlanActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(siterecovery.InstanceTypeManualActionDetails),
+						string(siterecovery.InstanceTypeScriptActionDetails),
+					}, false),
+				},
+				"fabric_location": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(siterecovery.Primary),
+						string(siterecovery.Recovery),
+					}, false),
+				},
+				"manual_action_instruction": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"script_path": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmRecoveryServicesReplicationRecoveryPlanCreate(d *schema.ResourceData, meta interface{}) error {
+	resGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	name := d.Get("name").(string)
+
+	client := meta.(*ArmClient).RecoveryServices.RecoveryPlanClient(resGroup, vaultName)
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing recovery services replication recovery plan %s: %+v", name, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_recovery_services_replication_recovery_plan", azure.HandleAzureSdkForGoBug2824(*existing.ID))
+		}
+	}
+
+	parameters := siterecovery.CreateRecoveryPlanInput{
+		Properties: &siterecovery.CreateRecoveryPlanInputProperties{
+			PrimaryFabricID:         utils.String(d.Get("source_recovery_fabric_id").(string)),
+			RecoveryFabricID:        utils.String(d.Get("target_recovery_fabric_id").(string)),
+			FailoverDeploymentModel: siterecovery.ResourceManager,
+			Groups:                  expandArmRecoveryServicesReplicationRecoveryPlanGroups(d.Get("recovery_plan_group").([]interface{})),
+		},
+	}
+
+	future, err := client.Create(ctx, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating recovery services replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error creating recovery services replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	resp, err := client.Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving recovery services replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	d.SetId(azure.HandleAzureSdkForGoBug2824(*resp.ID))
+
+	return resourceArmRecoveryServicesReplicationRecoveryPlanRead(d, meta)
+}
+
+func resourceArmRecoveryServicesReplicationRecoveryPlanUpdate(d *schema.ResourceData, meta interface{}) error {
+	resGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	name := d.Get("name").(string)
+
+	client := meta.(*ArmClient).RecoveryServices.RecoveryPlanClient(resGroup, vaultName)
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	parameters := siterecovery.UpdateRecoveryPlanInput{
+		Properties: &siterecovery.UpdateRecoveryPlanInputProperties{
+			Groups: expandArmRecoveryServicesReplicationRecoveryPlanGroups(d.Get("recovery_plan_group").([]interface{})),
+		},
+	}
+
+	future, err := client.Update(ctx, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error updating recovery services replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error updating recovery services replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	resp, err := client.Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving recovery services replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	d.SetId(azure.HandleAzureSdkForGoBug2824(*resp.ID))
+
+	return resourceArmRecoveryServicesReplicationRecoveryPlanRead(d, meta)
+}
+
+func resourceArmRecoveryServicesReplicationRecoveryPlanRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	name := id.Path["replicationRecoveryPlans"]
+
+	client := meta.(*ArmClient).RecoveryServices.RecoveryPlanClient(resGroup, vaultName)
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	resp, err := client.Get(ctx, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on recovery services replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("recovery_vault_name", vaultName)
+
+	if props := resp.Properties; props != nil {
+		d.Set("source_recovery_fabric_id", props.PrimaryFabricID)
+		d.Set("target_recovery_fabric_id", props.RecoveryFabricID)
+
+		if err := d.Set("recovery_plan_group", flattenArmRecoveryServicesReplicationRecoveryPlanGroups(props.Groups)); err != nil {
+			return fmt.Errorf("Error setting `recovery_plan_group`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmRecoveryServicesReplicationRecoveryPlanDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	name := id.Path["replicationRecoveryPlans"]
+
+	client := meta.(*ArmClient).RecoveryServices.RecoveryPlanClient(resGroup, vaultName)
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	future, err := client.Delete(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting recovery services replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of recovery services replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	return nil
+}
+
+func expandArmRecoveryServicesReplicationRecoveryPlanGroups(input []interface{}) *[]siterecovery.RecoveryPlanGroup {
+	groups := make([]siterecovery.RecoveryPlanGroup, 0)
+
+	for _, v := range input {
+		group := v.(map[string]interface{})
+
+		items := make([]siterecovery.RecoveryPlanProtectedItem, 0)
+		for _, itemId := range group["replicated_protected_items"].([]interface{}) {
+			items = append(items, siterecovery.RecoveryPlanProtectedItem{
+				ID: utils.String(itemId.(string)),
+			})
+		}
+
+		groups = append(groups, siterecovery.RecoveryPlanGroup{
+			GroupType:                 siterecovery.RecoveryPlanGroupType(group["type"].(string)),
+			ReplicationProtectedItems: &items,
+			StartGroupActions:         expandArmRecoveryServicesReplicationRecoveryPlanActions(group["pre_action"].([]interface{})),
+			EndGroupActions:           expandArmRecoveryServicesReplicationRecoveryPlanActions(group["post_action"].([]interface{})),
+		})
+	}
+
+	return &groups
+}
+
+func expandArmRecoveryServicesReplicationRecoveryPlanActions(input []interface{}) *[]siterecovery.RecoveryPlanAction {
+	actions := make([]siterecovery.RecoveryPlanAction, 0)
+
+	for _, v := range input {
+		action := v.(map[string]interface{})
+
+		var details siterecovery.BasicRecoveryPlanActionDetails
+		switch action["type"].(string) {
+		case string(siterecovery.InstanceTypeManualActionDetails):
+			details = &siterecovery.RecoveryPlanManualActionDetails{
+				Description: utils.String(action["manual_action_instruction"].(string)),
+			}
+		case string(siterecovery.InstanceTypeScriptActionDetails):
+			details = &siterecovery.RecoveryPlanScriptActionDetails{
+				Path:           utils.String(action["script_path"].(string)),
+				FabricLocation: siterecovery.RecoveryPlanActionLocation(action["fabric_location"].(string)),
+			}
+		}
+
+		actions = append(actions, siterecovery.RecoveryPlanAction{
+			ActionName:    utils.String(action["name"].(string)),
+			CustomDetails: details,
+		})
+	}
+
+	return &actions
+}
+
+func flattenArmRecoveryServicesReplicationRecoveryPlanGroups(input *[]siterecovery.RecoveryPlanGroup) []interface{} {
+	groups := make([]interface{}, 0)
+	if input == nil {
+		return groups
+	}
+
+	for _, group := range *input {
+		items := make([]interface{}, 0)
+		if group.ReplicationProtectedItems != nil {
+			for _, item := range *group.ReplicationProtectedItems {
+				if item.ID != nil {
+					items = append(items, *item.ID)
+				}
+			}
+		}
+
+		groups = append(groups, map[string]interface{}{
+			"type":                       string(group.GroupType),
+			"replicated_protected_items": items,
+			"pre_action":                 flattenArmRecoveryServicesReplicationRecoveryPlanActions(group.StartGroupActions),
+			"post_action":                flattenArmRecoveryServicesReplicationRecoveryPlanActions(group.EndGroupActions),
+		})
+	}
+
+	return groups
+}
+
+func flattenArmRecoveryServicesReplicationRecoveryPlanActions(input *[]siterecovery.RecoveryPlanAction) []interface{} {
+	actions := make([]interface{}, 0)
+	if input == nil {
+		return actions
+	}
+
+	for _, action := range *input {
+		a := map[string]interface{}{
+			"name": "",
+			"type": "",
+		}
+		if action.ActionName != nil {
+			a["name"] = *action.ActionName
+		}
+
+		if manual, isManual := action.CustomDetails.AsRecoveryPlanManualActionDetails(); isManual {
+			a["type"] = string(siterecovery.InstanceTypeManualActionDetails)
+			if manual.Description != nil {
+				a["manual_action_instruction"] = *manual.Description
+			}
+		}
+		if script, isScript := action.CustomDetails.AsRecoveryPlanScriptActionDetails(); isScript {
+			a["type"] = string(siterecovery.InstanceTypeScriptActionDetails)
+			if script.Path != nil {
+				a["script_path"] = *script.Path
+			}
+			a["fabric_location"] = string(script.FabricLocation)
+		}
+
+		actions = append(actions, a)
+	}
+
+	return actions
+}
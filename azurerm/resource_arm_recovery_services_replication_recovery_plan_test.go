@@ -0,0 +1,121 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMRecoveryServicesReplicationRecoveryPlan_basic(t *testing.T) {
+	resourceGroupName := "azurerm_resource_group.test"
+	vaultName := "azurerm_recovery_services_vault.test"
+	resourceName := "azurerm_recovery_services_replication_recovery_plan.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesReplicationRecoveryPlan_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesReplicationRecoveryPlanExists(resourceGroupName, vaultName, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "recovery_plan_group.0.type", "Boot"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMRecoveryServicesReplicationRecoveryPlan_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-vault-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+
+resource "azurerm_recovery_services_fabric" "source" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  name                = "acctest-fabric-source-%d"
+  location            = "%s"
+}
+
+resource "azurerm_recovery_services_fabric" "target" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  name                = "acctest-fabric-target-%d"
+  location            = "%s"
+}
+
+resource "azurerm_recovery_services_replication_recovery_plan" "test" {
+  name                      = "acctest-plan-%d"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  recovery_vault_name       = "${azurerm_recovery_services_vault.test.name}"
+  source_recovery_fabric_id = "${azurerm_recovery_services_fabric.source.id}"
+  target_recovery_fabric_id = "${azurerm_recovery_services_fabric.target.id}"
+
+  recovery_plan_group {
+    type = "Boot"
+
+    pre_action {
+      name                      = "pre-boot"
+      type                      = "Manual"
+      manual_action_instruction = "confirm maintenance window"
+    }
+  }
+}
+`, rInt, location, rInt, rInt, location, rInt, location, rInt)
+}
+
+func testCheckAzureRMRecoveryServicesReplicationRecoveryPlanExists(resourceGroupStateName, vaultStateName, planStateName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceGroupState, ok := s.RootModule().Resources[resourceGroupStateName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceGroupStateName)
+		}
+		vaultState, ok := s.RootModule().Resources[vaultStateName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", vaultStateName)
+		}
+		planState, ok := s.RootModule().Resources[planStateName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", planStateName)
+		}
+
+		resourceGroupName := resourceGroupState.Primary.Attributes["name"]
+		vaultName := vaultState.Primary.Attributes["name"]
+		planName := planState.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).RecoveryServices.RecoveryPlanClient(resourceGroupName, vaultName)
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, planName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on recoveryPlanClient: %+v", err)
+		}
+
+		if resp.Response.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: recovery plan: %q does not exist", planName)
+		}
+
+		return nil
+	}
+}
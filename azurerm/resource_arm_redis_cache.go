@@ -51,7 +51,7 @@ func resourceArmRedisCache() *schema.Resource {
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
-			"zones": azure.SchemaSingleZone(),
+			"zones": azure.SchemaZones(),
 
 			"capacity": {
 				Type:     schema.TypeInt,
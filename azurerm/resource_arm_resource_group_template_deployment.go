@@ -0,0 +1,212 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmResourceGroupTemplateDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmResourceGroupTemplateDeploymentCreateUpdate,
+		Read:   resourceArmResourceGroupTemplateDeploymentRead,
+		Update: resourceArmResourceGroupTemplateDeploymentCreateUpdate,
+		Delete: resourceArmResourceGroupTemplateDeploymentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"deployment_mode": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(resources.Complete),
+					string(resources.Incremental),
+				}, false),
+			},
+
+			"template_content": {
+				Type:      schema.TypeString,
+				Required:  true,
+				StateFunc: normalizeJson,
+			},
+
+			"parameters_content": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				StateFunc: normalizeJson,
+			},
+
+			"output_content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmResourceGroupTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Resource.DeploymentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Resource Group Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_resource_group_template_deployment", *existing.ID)
+		}
+	}
+
+	template, err := expandTemplateDeploymentBody(d.Get("template_content").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing `template_content`: %+v", err)
+	}
+
+	properties := resources.DeploymentProperties{
+		Mode:     resources.DeploymentMode(d.Get("deployment_mode").(string)),
+		Template: template,
+	}
+
+	if v, ok := d.GetOk("parameters_content"); ok {
+		parameters, err := expandTemplateDeploymentBody(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing `parameters_content`: %+v", err)
+		}
+		properties.Parameters = parameters
+	}
+
+	deployment := resources.Deployment{
+		Properties: &properties,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, deployment)
+	if err != nil {
+		return fmt.Errorf("Error creating Resource Group Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Resource Group Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Resource Group Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Resource Group Template Deployment %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmResourceGroupTemplateDeploymentRead(d, meta)
+}
+
+func resourceArmResourceGroupTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Resource.DeploymentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["deployments"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Resource Group Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.Properties; props != nil {
+		d.Set("deployment_mode", string(props.Mode))
+
+		outputContent, err := flattenTemplateDeploymentBody(props.Outputs)
+		if err != nil {
+			return fmt.Errorf("Error flattening `output_content`: %+v", err)
+		}
+		d.Set("output_content", outputContent)
+	}
+
+	return nil
+}
+
+func resourceArmResourceGroupTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Resource.DeploymentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["deployments"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Resource Group Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Resource Group Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandTemplateDeploymentBody(body string) (interface{}, error) {
+	var templateJSON interface{}
+	if err := json.Unmarshal([]byte(body), &templateJSON); err != nil {
+		return nil, fmt.Errorf("Error expanding JSON: %+v", err)
+	}
+	return templateJSON, nil
+}
+
+func flattenTemplateDeploymentBody(input interface{}) (string, error) {
+	if input == nil {
+		return "", nil
+	}
+
+	result, err := json.Marshal(input)
+	if err != nil {
+		return "", fmt.Errorf("Error flattening JSON: %+v", err)
+	}
+
+	return string(result), nil
+}
@@ -0,0 +1,162 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestAccAzureRMResourceGroupTemplateDeployment_basic(t *testing.T) {
+	resourceName := "azurerm_resource_group_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceGroupTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceGroupTemplateDeployment_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupTemplateDeploymentExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResourceGroupTemplateDeployment_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_resource_group_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceGroupTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceGroupTemplateDeployment_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupTemplateDeploymentExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMResourceGroupTemplateDeployment_requiresImport(ri, testLocation()),
+				ExpectError: testRequiresImportError("azurerm_resource_group_template_deployment"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMResourceGroupTemplateDeploymentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Resource Group Template Deployment: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).Resource.DeploymentsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on deploymentsClient: %s", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Resource Group Template Deployment %q (resource group: %q) does not exist", name, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMResourceGroupTemplateDeploymentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Resource.DeploymentsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_resource_group_template_deployment" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Resource Group Template Deployment still exists:\n%#v", resp.Properties)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMResourceGroupTemplateDeployment_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctestdeployment-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [],
+  "outputs": {
+    "testOutput": {
+      "type": "string",
+      "value": "Hello World"
+    }
+  }
+}
+TEMPLATE
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMResourceGroupTemplateDeployment_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMResourceGroupTemplateDeployment_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_template_deployment" "import" {
+  name                = "${azurerm_resource_group_template_deployment.test.name}"
+  resource_group_name = "${azurerm_resource_group_template_deployment.test.resource_group_name}"
+  deployment_mode     = "${azurerm_resource_group_template_deployment.test.deployment_mode}"
+  template_content    = "${azurerm_resource_group_template_deployment.test.template_content}"
+}
+`, template)
+}
@@ -304,6 +304,40 @@ func resourceArmSqlDatabase() *schema.Resource {
 				},
 			},
 
+			"extended_auditing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"storage_account_access_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"storage_endpoint": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"storage_account_access_key_is_secondary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"retention_in_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
 			"read_scale": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -487,6 +521,12 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error setting database threat detection policy: %+v", err)
 	}
 
+	auditingClient := meta.(*ArmClient).Sql.DatabaseBlobAuditingPoliciesClient
+	auditingPolicy := expandArmSqlDatabaseExtendedAuditingPolicy(d)
+	if _, err = auditingClient.CreateOrUpdate(ctx, resourceGroup, serverName, name, auditingPolicy); err != nil {
+		return fmt.Errorf("Error setting database extended auditing policy: %+v", err)
+	}
+
 	return resourceArmSqlDatabaseRead(d, meta)
 }
 
@@ -524,6 +564,14 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	auditingClient := meta.(*ArmClient).Sql.DatabaseBlobAuditingPoliciesClient
+	auditingPolicy, err := auditingClient.Get(ctx, resourceGroup, serverName, name)
+	if err == nil {
+		if err := d.Set("extended_auditing_policy", flattenArmSqlDatabaseExtendedAuditingPolicy(d, auditingPolicy)); err != nil {
+			return fmt.Errorf("Error setting `extended_auditing_policy`: %+v", err)
+		}
+	}
+
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resourceGroup)
 	if location := resp.Location; location != nil {
@@ -660,6 +708,59 @@ func flattenArmSqlServerThreatDetectionPolicy(d *schema.ResourceData, policy sql
 	return []interface{}{threatDetectionPolicy}
 }
 
+func expandArmSqlDatabaseExtendedAuditingPolicy(d *schema.ResourceData) sql.DatabaseBlobAuditingPolicy {
+	policy := sql.DatabaseBlobAuditingPolicy{
+		DatabaseBlobAuditingPolicyProperties: &sql.DatabaseBlobAuditingPolicyProperties{
+			State: sql.BlobAuditingPolicyStateDisabled,
+		},
+	}
+	properties := policy.DatabaseBlobAuditingPolicyProperties
+
+	auditingPolicies := d.Get("extended_auditing_policy").([]interface{})
+	if len(auditingPolicies) == 0 || auditingPolicies[0] == nil {
+		return policy
+	}
+
+	auditingPolicy := auditingPolicies[0].(map[string]interface{})
+
+	properties.State = sql.BlobAuditingPolicyStateEnabled
+	properties.StorageEndpoint = utils.String(auditingPolicy["storage_endpoint"].(string))
+	properties.StorageAccountAccessKey = utils.String(auditingPolicy["storage_account_access_key"].(string))
+	properties.IsStorageSecondaryKeyInUse = utils.Bool(auditingPolicy["storage_account_access_key_is_secondary"].(bool))
+
+	if v, ok := auditingPolicy["retention_in_days"]; ok {
+		properties.RetentionDays = utils.Int32(int32(v.(int)))
+	}
+
+	return policy
+}
+
+func flattenArmSqlDatabaseExtendedAuditingPolicy(d *schema.ResourceData, policy sql.DatabaseBlobAuditingPolicy) []interface{} {
+	properties := policy.DatabaseBlobAuditingPolicyProperties
+	if properties == nil || properties.State == sql.BlobAuditingPolicyStateDisabled {
+		return []interface{}{}
+	}
+
+	auditingPolicy := make(map[string]interface{})
+
+	if properties.StorageEndpoint != nil {
+		auditingPolicy["storage_endpoint"] = *properties.StorageEndpoint
+	}
+	if properties.IsStorageSecondaryKeyInUse != nil {
+		auditingPolicy["storage_account_access_key_is_secondary"] = *properties.IsStorageSecondaryKeyInUse
+	}
+	if properties.RetentionDays != nil {
+		auditingPolicy["retention_in_days"] = int(*properties.RetentionDays)
+	}
+
+	// The API does not return the storage account access key for security reasons, so pull it through from state
+	if v, ok := d.GetOk("extended_auditing_policy.0.storage_account_access_key"); ok {
+		auditingPolicy["storage_account_access_key"] = v.(string)
+	}
+
+	return []interface{}{auditingPolicy}
+}
+
 func expandAzureRmSqlDatabaseImport(d *schema.ResourceData) sql.ImportExtensionRequest {
 	v := d.Get("import")
 	dbimportRefs := v.([]interface{})
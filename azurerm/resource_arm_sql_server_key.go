@@ -0,0 +1,189 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSqlServerKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSqlServerKeyCreateUpdate,
+		Read:   resourceArmSqlServerKeyRead,
+		Update: resourceArmSqlServerKeyCreateUpdate,
+		Delete: resourceArmSqlServerKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"server_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"key_vault_key_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateKeyVaultChildId,
+			},
+
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmSqlServerKeyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Sql.ServerKeysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	keyVaultKeyId := d.Get("key_vault_key_id").(string)
+
+	keyName, err := keyNameFromKeyVaultKeyId(keyVaultKeyId)
+	if err != nil {
+		return fmt.Errorf("Error determining Server Key name from %q: %+v", keyVaultKeyId, err)
+	}
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, serverName, *keyName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing SQL Server Key (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_sql_server_key", *existing.ID)
+		}
+	}
+
+	parameters := sql.ServerKey{
+		ServerKeyProperties: &sql.ServerKeyProperties{
+			ServerKeyType: sql.AzureKeyVault,
+			URI:           utils.String(keyVaultKeyId),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, *keyName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating SQL Server Key (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of SQL Server Key (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, *keyName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Server Key (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmSqlServerKeyRead(d, meta)
+}
+
+func resourceArmSqlServerKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Sql.ServerKeysClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	keyName := id.Path["keys"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, keyName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] SQL Server Key %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading SQL Server Key (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+
+	if props := resp.ServerKeyProperties; props != nil {
+		d.Set("key_vault_key_id", props.URI)
+
+		if props.CreationDate != nil {
+			d.Set("creation_date", props.CreationDate.String())
+		}
+	}
+
+	return nil
+}
+
+func resourceArmSqlServerKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Sql.ServerKeysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	keyName := id.Path["keys"]
+
+	future, err := client.Delete(ctx, resourceGroup, serverName, keyName)
+	if err != nil {
+		return fmt.Errorf("Error deleting SQL Server Key (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of SQL Server Key (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// keyNameFromKeyVaultKeyId derives the `vault_key_version` name the SQL Server Keys API
+// expects from a Key Vault Key ID, e.g. a Key Vault Key ID of
+// `https://myvault.vault.azure.net/keys/mykey/ec96f02080254f109c51a1f14cdb1931` becomes
+// `myvault_mykey_ec96f02080254f109c51a1f14cdb1931`.
+func keyNameFromKeyVaultKeyId(keyVaultKeyId string) (*string, error) {
+	parsed, err := azure.ParseKeyVaultChildID(keyVaultKeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	vaultURL, err := url.Parse(parsed.KeyVaultBaseUrl)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Key Vault ID %q: %+v", parsed.KeyVaultBaseUrl, err)
+	}
+
+	vaultName := strings.Split(vaultURL.Host, ".")[0]
+
+	name := fmt.Sprintf("%s_%s_%s", vaultName, parsed.Name, parsed.Version)
+	return &name, nil
+}
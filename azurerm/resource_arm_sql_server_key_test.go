@@ -0,0 +1,210 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSqlServerKey_basic(t *testing.T) {
+	resourceName := "azurerm_sql_server_key.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlServerKey_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerKeyExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlServerKey_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_sql_server_key.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlServerKey_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerKeyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMSqlServerKey_requiresImport(ri, testLocation()),
+				ExpectError: testRequiresImportError("azurerm_sql_server_key"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSqlServerKeyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+
+		keyName, err := keyNameFromKeyVaultKeyId(rs.Primary.Attributes["key_vault_key_id"])
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).Sql.ServerKeysClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, *keyName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: SQL Server Key %q (Server %q / Resource Group %q) does not exist", *keyName, serverName, resourceGroup)
+			}
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSqlServerKeyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Sql.ServerKeysClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_sql_server_key" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+
+		keyName, err := keyNameFromKeyVaultKeyId(rs.Primary.Attributes["key_vault_key_id"])
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, *keyName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("SQL Server Key still exists: %q", *resp.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSqlServerKey_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  location                    = "${azurerm_resource_group.test.location}"
+  version                     = "12.0"
+  administrator_login         = "missadministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "create",
+      "get",
+    ]
+  }
+
+  purge_protection_enabled = true
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "acctestkvk%d"
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMSqlServerKey_basic(rInt int, location string) string {
+	template := testAccAzureRMSqlServerKey_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_server_key" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name          = "${azurerm_sql_server.test.name}"
+  key_vault_key_id     = "${azurerm_key_vault_key.test.id}"
+}
+`, template)
+}
+
+func testAccAzureRMSqlServerKey_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMSqlServerKey_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_server_key" "import" {
+  resource_group_name = "${azurerm_sql_server_key.test.resource_group_name}"
+  server_name          = "${azurerm_sql_server_key.test.server_name}"
+  key_vault_key_id     = "${azurerm_sql_server_key.test.key_vault_key_id}"
+}
+`, template)
+}
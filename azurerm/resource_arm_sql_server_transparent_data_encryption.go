@@ -0,0 +1,154 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const sqlServerTransparentDataEncryptionServiceManagedKeyName = "ServiceManaged"
+
+func resourceArmSqlServerTransparentDataEncryption() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSqlServerTransparentDataEncryptionCreateUpdate,
+		Read:   resourceArmSqlServerTransparentDataEncryptionRead,
+		Update: resourceArmSqlServerTransparentDataEncryptionCreateUpdate,
+		Delete: resourceArmSqlServerTransparentDataEncryptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"server_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"key_vault_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateKeyVaultChildId,
+			},
+		},
+	}
+}
+
+func resourceArmSqlServerTransparentDataEncryptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Sql.EncryptionProtectorsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+
+	properties := &sql.EncryptionProtectorProperties{
+		ServerKeyType: sql.ServiceManaged,
+		ServerKeyName: utils.String(sqlServerTransparentDataEncryptionServiceManagedKeyName),
+	}
+
+	if v, ok := d.GetOk("key_vault_key_id"); ok {
+		keyName, err := keyNameFromKeyVaultKeyId(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error determining Server Key name from %q: %+v", v.(string), err)
+		}
+
+		properties.ServerKeyType = sql.AzureKeyVault
+		properties.ServerKeyName = keyName
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, sql.EncryptionProtector{
+		EncryptionProtectorProperties: properties,
+	})
+	if err != nil {
+		return fmt.Errorf("Error setting Transparent Data Encryption protector (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Transparent Data Encryption protector (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, serverName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Transparent Data Encryption protector (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmSqlServerTransparentDataEncryptionRead(d, meta)
+}
+
+func resourceArmSqlServerTransparentDataEncryptionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Sql.EncryptionProtectorsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Transparent Data Encryption protector for SQL Server %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading Transparent Data Encryption protector (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+
+	if props := resp.EncryptionProtectorProperties; props != nil {
+		if props.ServerKeyType == sql.AzureKeyVault && props.URI != nil {
+			d.Set("key_vault_key_id", props.URI)
+		} else {
+			d.Set("key_vault_key_id", "")
+		}
+	}
+
+	return nil
+}
+
+func resourceArmSqlServerTransparentDataEncryptionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Sql.EncryptionProtectorsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, sql.EncryptionProtector{
+		EncryptionProtectorProperties: &sql.EncryptionProtectorProperties{
+			ServerKeyType: sql.ServiceManaged,
+			ServerKeyName: utils.String(sqlServerTransparentDataEncryptionServiceManagedKeyName),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error reverting Transparent Data Encryption protector to service-managed (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Transparent Data Encryption protector to revert to service-managed (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMSqlServerTransparentDataEncryption_customerManagedKey(t *testing.T) {
+	resourceName := "azurerm_sql_server_transparent_data_encryption.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlServerTransparentDataEncryption_customerManagedKey(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerTransparentDataEncryptionExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "key_vault_key_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSqlServerTransparentDataEncryptionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).Sql.EncryptionProtectorsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if _, err := client.Get(ctx, resourceGroup, serverName); err != nil {
+			return fmt.Errorf("Bad: Transparent Data Encryption protector (Server %q / Resource Group %q) does not exist: %+v", serverName, resourceGroup, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMSqlServerTransparentDataEncryption_customerManagedKey(rInt int, location string) string {
+	template := testAccAzureRMSqlServerKey_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_server_transparent_data_encryption" "test" {
+  resource_group_name = "${azurerm_sql_server_key.test.resource_group_name}"
+  server_name          = "${azurerm_sql_server_key.test.server_name}"
+  key_vault_key_id     = "${azurerm_sql_server_key.test.key_vault_key_id}"
+}
+`, template)
+}
@@ -416,6 +416,95 @@ func resourceArmStorageAccount() *schema.Resource {
 				},
 			},
 
+			"blob_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cors_rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 5,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_origins": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 64,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+									"exposed_headers": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 64,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+									"allowed_headers": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 64,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+									"allowed_methods": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 64,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"DELETE",
+												"GET",
+												"HEAD",
+												"MERGE",
+												"POST",
+												"OPTIONS",
+												"PUT"}, false),
+										},
+									},
+									"max_age_in_seconds": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 2000000000),
+									},
+								},
+							},
+						},
+						"delete_retention_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      7,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
+						"default_service_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
 			"primary_location": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -758,6 +847,16 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if val, ok := d.GetOk("blob_properties"); ok {
+		blobClient := meta.(*ArmClient).Storage.BlobServicesClient
+
+		blobProperties := expandBlobProperties(val.([]interface{}))
+
+		if _, err = blobClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, blobProperties); err != nil {
+			return fmt.Errorf("Error updating Azure Storage Account `blob_properties` %q: %+v", storageAccountName, err)
+		}
+	}
+
 	return resourceArmStorageAccountRead(d, meta)
 }
 
@@ -954,6 +1053,18 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("queue_properties")
 	}
 
+	if d.HasChange("blob_properties") {
+		blobClient := meta.(*ArmClient).Storage.BlobServicesClient
+
+		blobProperties := expandBlobProperties(d.Get("blob_properties").([]interface{}))
+
+		if _, err := blobClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, blobProperties); err != nil {
+			return fmt.Errorf("Error updating Azure Storage Account `blob_properties` %q: %+v", storageAccountName, err)
+		}
+
+		d.SetPartial("blob_properties")
+	}
+
 	d.Partial(false)
 	return resourceArmStorageAccountRead(d, meta)
 }
@@ -1136,6 +1247,19 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error setting `queue_properties `for AzureRM Storage Account %q: %+v", name, err)
 	}
 
+	blobClient := meta.(*ArmClient).Storage.BlobServicesClient
+
+	blobProps, err := blobClient.GetServiceProperties(ctx, resGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(blobProps.Response) {
+			return fmt.Errorf("Error reading blob properties for AzureRM Storage Account %q: %+v", name, err)
+		}
+	}
+
+	if err := d.Set("blob_properties", flattenBlobProperties(blobProps)); err != nil {
+		return fmt.Errorf("Error setting `blob_properties `for AzureRM Storage Account %q: %+v", name, err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -1397,6 +1521,145 @@ func expandQueuePropertiesCors(input []interface{}) *queues.Cors {
 	return cors
 }
 
+func expandBlobProperties(input []interface{}) storage.BlobServiceProperties {
+	props := storage.BlobServiceProperties{
+		BlobServicePropertiesProperties: &storage.BlobServicePropertiesProperties{
+			Cors: &storage.CorsRules{
+				CorsRules: &[]storage.CorsRule{},
+			},
+			DeleteRetentionPolicy: &storage.DeleteRetentionPolicy{
+				Enabled: utils.Bool(false),
+			},
+		},
+	}
+	if len(input) == 0 {
+		return props
+	}
+
+	v := input[0].(map[string]interface{})
+
+	deleteRetentionPolicy := v["delete_retention_policy"].([]interface{})
+	props.BlobServicePropertiesProperties.DeleteRetentionPolicy = expandBlobPropertiesDeleteRetentionPolicy(deleteRetentionPolicy)
+
+	corsRaw := v["cors_rule"].([]interface{})
+	props.BlobServicePropertiesProperties.Cors = expandBlobPropertiesCors(corsRaw)
+
+	if version, ok := v["default_service_version"].(string); ok && version != "" {
+		props.BlobServicePropertiesProperties.DefaultServiceVersion = utils.String(version)
+	}
+
+	return props
+}
+
+func expandBlobPropertiesDeleteRetentionPolicy(input []interface{}) *storage.DeleteRetentionPolicy {
+	result := storage.DeleteRetentionPolicy{
+		Enabled: utils.Bool(false),
+	}
+	if len(input) == 0 {
+		return &result
+	}
+
+	policy := input[0].(map[string]interface{})
+	result.Enabled = utils.Bool(true)
+	result.Days = utils.Int32(int32(policy["days"].(int)))
+
+	return &result
+}
+
+func expandBlobPropertiesCors(input []interface{}) *storage.CorsRules {
+	corsRules := make([]storage.CorsRule, 0)
+	if len(input) == 0 {
+		return &storage.CorsRules{
+			CorsRules: &corsRules,
+		}
+	}
+
+	for _, attr := range input {
+		corsRuleAttr := attr.(map[string]interface{})
+		corsRule := storage.CorsRule{
+			AllowedOrigins:  utils.ExpandStringSlice(corsRuleAttr["allowed_origins"].([]interface{})),
+			ExposedHeaders:  utils.ExpandStringSlice(corsRuleAttr["exposed_headers"].([]interface{})),
+			AllowedHeaders:  utils.ExpandStringSlice(corsRuleAttr["allowed_headers"].([]interface{})),
+			AllowedMethods:  utils.ExpandStringSlice(corsRuleAttr["allowed_methods"].([]interface{})),
+			MaxAgeInSeconds: utils.Int32(int32(corsRuleAttr["max_age_in_seconds"].(int))),
+		}
+		corsRules = append(corsRules, corsRule)
+	}
+
+	return &storage.CorsRules{
+		CorsRules: &corsRules,
+	}
+}
+
+func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
+	if input.BlobServicePropertiesProperties == nil {
+		return []interface{}{}
+	}
+
+	flattenedCorsRules := make([]interface{}, 0)
+	if cors := input.BlobServicePropertiesProperties.Cors; cors != nil {
+		flattenedCorsRules = flattenBlobPropertiesCorsRule(cors.CorsRules)
+	}
+
+	flattenedDeleteRetentionPolicy := make([]interface{}, 0)
+	if deleteRetentionPolicy := input.BlobServicePropertiesProperties.DeleteRetentionPolicy; deleteRetentionPolicy != nil {
+		flattenedDeleteRetentionPolicy = flattenBlobPropertiesDeleteRetentionPolicy(deleteRetentionPolicy)
+	}
+
+	defaultServiceVersion := ""
+	if input.BlobServicePropertiesProperties.DefaultServiceVersion != nil {
+		defaultServiceVersion = *input.BlobServicePropertiesProperties.DefaultServiceVersion
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cors_rule":               flattenedCorsRules,
+			"delete_retention_policy": flattenedDeleteRetentionPolicy,
+			"default_service_version": defaultServiceVersion,
+		},
+	}
+}
+
+func flattenBlobPropertiesCorsRule(input *[]storage.CorsRule) []interface{} {
+	corsRules := make([]interface{}, 0)
+	if input == nil {
+		return corsRules
+	}
+
+	for _, corsRule := range *input {
+		corsRules = append(corsRules, map[string]interface{}{
+			"allowed_headers":    utils.FlattenStringSlice(corsRule.AllowedHeaders),
+			"allowed_origins":    utils.FlattenStringSlice(corsRule.AllowedOrigins),
+			"allowed_methods":    utils.FlattenStringSlice(corsRule.AllowedMethods),
+			"exposed_headers":    utils.FlattenStringSlice(corsRule.ExposedHeaders),
+			"max_age_in_seconds": int(*corsRule.MaxAgeInSeconds),
+		})
+	}
+
+	return corsRules
+}
+
+func flattenBlobPropertiesDeleteRetentionPolicy(input *storage.DeleteRetentionPolicy) []interface{} {
+	deleteRetentionPolicy := make([]interface{}, 0)
+
+	if input == nil {
+		return deleteRetentionPolicy
+	}
+
+	if enabled := input.Enabled; enabled != nil && *enabled {
+		days := 0
+		if input.Days != nil {
+			days = int(*input.Days)
+		}
+
+		deleteRetentionPolicy = append(deleteRetentionPolicy, map[string]interface{}{
+			"days": days,
+		})
+	}
+
+	return deleteRetentionPolicy
+}
+
 func flattenStorageAccountNetworkRules(input *storage.NetworkRuleSet) []interface{} {
 	if len(*input.IPRules) == 0 && len(*input.VirtualNetworkRules) == 0 {
 		return []interface{}{}
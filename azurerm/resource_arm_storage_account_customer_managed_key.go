@@ -0,0 +1,189 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStorageAccountCustomerManagedKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageAccountCustomerManagedKeyCreateUpdate,
+		Read:   resourceArmStorageAccountCustomerManagedKeyRead,
+		Update: resourceArmStorageAccountCustomerManagedKeyCreateUpdate,
+		Delete: resourceArmStorageAccountCustomerManagedKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"key_vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"key_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"key_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func resourceArmStorageAccountCustomerManagedKeyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Storage.AccountsClient
+	keyVaultClient := meta.(*ArmClient).KeyVault.VaultsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	storageAccountId := d.Get("storage_account_id").(string)
+
+	id, err := azure.ParseAzureResourceID(storageAccountId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	locks.ByName(storageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(storageAccountName, storageAccountResourceName)
+
+	account, err := client.GetProperties(ctx, resourceGroup, storageAccountName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+	if account.Identity == nil || account.Identity.PrincipalID == nil {
+		return fmt.Errorf("Storage Account %q (Resource Group %q) must have a System Assigned Managed Identity configured to use a Customer Managed Key", storageAccountName, resourceGroup)
+	}
+
+	keyVaultId := d.Get("key_vault_id").(string)
+	keyVaultBaseUrl, err := azure.GetKeyVaultBaseUrlFromID(ctx, keyVaultClient, keyVaultId)
+	if err != nil {
+		return fmt.Errorf("Error looking up Key Vault URI from Key Vault %q: %+v", keyVaultId, err)
+	}
+
+	opts := storage.AccountUpdateParameters{
+		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+			Encryption: &storage.Encryption{
+				Services: &storage.EncryptionServices{
+					Blob: &storage.EncryptionService{Enabled: utils.Bool(true)},
+					File: &storage.EncryptionService{Enabled: utils.Bool(true)},
+				},
+				KeySource: storage.MicrosoftKeyvault,
+				KeyVaultProperties: &storage.KeyVaultProperties{
+					KeyName:     utils.String(d.Get("key_name").(string)),
+					KeyVersion:  utils.String(d.Get("key_version").(string)),
+					KeyVaultURI: utils.String(keyVaultBaseUrl),
+				},
+			},
+		},
+	}
+
+	if _, err := client.Update(ctx, resourceGroup, storageAccountName, opts); err != nil {
+		return fmt.Errorf("Error updating Customer Managed Key for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	d.SetId(storageAccountId)
+
+	return resourceArmStorageAccountCustomerManagedKeyRead(d, meta)
+}
+
+func resourceArmStorageAccountCustomerManagedKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Storage.AccountsClient
+	keyVaultClient := meta.(*ArmClient).KeyVault.VaultsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	resp, err := client.GetProperties(ctx, resourceGroup, storageAccountName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Storage Account %q (Resource Group %q) was not found - removing Customer Managed Key from state", storageAccountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	d.Set("storage_account_id", resp.ID)
+
+	if props := resp.AccountProperties; props != nil && props.Encryption != nil && props.Encryption.KeyVaultProperties != nil {
+		kvProps := props.Encryption.KeyVaultProperties
+		d.Set("key_name", kvProps.KeyName)
+		d.Set("key_version", kvProps.KeyVersion)
+
+		if kvProps.KeyVaultURI != nil {
+			keyVaultId, err := azure.GetKeyVaultIDFromBaseUrl(ctx, keyVaultClient, *kvProps.KeyVaultURI)
+			if err != nil {
+				return fmt.Errorf("Error retrieving the Resource ID for the Key Vault at URL %q: %+v", *kvProps.KeyVaultURI, err)
+			}
+			d.Set("key_vault_id", keyVaultId)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStorageAccountCustomerManagedKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Storage.AccountsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	locks.ByName(storageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(storageAccountName, storageAccountResourceName)
+
+	log.Printf("[DEBUG] Reverting Storage Account %q (Resource Group %q) to Microsoft-managed keys", storageAccountName, resourceGroup)
+
+	opts := storage.AccountUpdateParameters{
+		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+			Encryption: &storage.Encryption{
+				Services: &storage.EncryptionServices{
+					Blob: &storage.EncryptionService{Enabled: utils.Bool(true)},
+					File: &storage.EncryptionService{Enabled: utils.Bool(true)},
+				},
+				KeySource: storage.MicrosoftStorage,
+			},
+		},
+	}
+	if _, err := client.Update(ctx, resourceGroup, storageAccountName, opts); err != nil {
+		return fmt.Errorf("Error reverting Customer Managed Key for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMStorageAccountCustomerManagedKey_basic(t *testing.T) {
+	resourceName := "azurerm_storage_account_customer_managed_key.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageAccountCustomerManagedKey_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists("azurerm_storage_account.test"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_vault_id"),
+					resource.TestCheckResourceAttr(resourceName, "key_name", "examplekey"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMStorageAccountCustomerManagedKey_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "test" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                     = "acctestkv%s"
+  location                 = "${azurerm_resource_group.test.location}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  tenant_id                = "${data.azurerm_client_config.test.tenant_id}"
+  sku_name                 = "standard"
+  purge_protection_enabled = true
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_key_vault_access_policy" "storage" {
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  tenant_id    = "${azurerm_storage_account.test.identity.0.tenant_id}"
+  object_id    = "${azurerm_storage_account.test.identity.0.principal_id}"
+
+  key_permissions = ["get", "wrapkey", "unwrapkey"]
+}
+
+resource "azurerm_key_vault_access_policy" "client" {
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  tenant_id    = "${data.azurerm_client_config.test.tenant_id}"
+  object_id    = "${data.azurerm_client_config.test.object_id}"
+
+  key_permissions = ["create", "get", "delete", "purge"]
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "examplekey"
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  key_type     = "RSA"
+  key_size     = 2048
+  key_opts     = ["decrypt", "encrypt", "sign", "unwrapKey", "verify", "wrapKey"]
+
+  depends_on = ["azurerm_key_vault_access_policy.storage", "azurerm_key_vault_access_policy.client"]
+}
+
+resource "azurerm_storage_account_customer_managed_key" "test" {
+  storage_account_id = "${azurerm_storage_account.test.id}"
+  key_vault_id       = "${azurerm_key_vault.test.id}"
+  key_name           = "${azurerm_key_vault_key.test.name}"
+  key_version        = "${azurerm_key_vault_key.test.version}"
+}
+`, rInt, location, rString, rString)
+}
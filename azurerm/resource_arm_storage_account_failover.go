@@ -0,0 +1,125 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// resourceArmStorageAccountFailover is a one-shot action resource: `Create` triggers the account
+// failover to the secondary region and waits for it to complete, `Delete` simply forgets about it
+// again (there's no way to fail back through this API) and `Update` is intentionally not wired up -
+// to trigger another failover the resource needs to be tainted/recreated.
+func resourceArmStorageAccountFailover() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageAccountFailoverCreate,
+		Read:   resourceArmStorageAccountFailoverRead,
+		Delete: resourceArmStorageAccountFailoverDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"last_geo_failover_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmStorageAccountFailoverCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Storage.AccountsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	storageAccountId := d.Get("storage_account_id").(string)
+	id, err := azure.ParseAzureResourceID(storageAccountId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	locks.ByName(storageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(storageAccountName, storageAccountResourceName)
+
+	account, err := client.GetProperties(ctx, resourceGroup, storageAccountName, storage.AccountExpandGeoReplicationStats)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	if props := account.AccountProperties; props == nil || props.GeoReplicationStats == nil || props.GeoReplicationStats.CanFailover == nil || !*props.GeoReplicationStats.CanFailover {
+		return fmt.Errorf("Storage Account %q (Resource Group %q) does not support failover - it must use Geo-Redundant (GRS) or Read-Access Geo-Redundant (RA-GRS) replication", storageAccountName, resourceGroup)
+	}
+
+	log.Printf("[DEBUG] Triggering failover for Storage Account %q (Resource Group %q)..", storageAccountName, resourceGroup)
+	future, err := client.Failover(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		return fmt.Errorf("Error triggering failover for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for failover of Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Failover of Storage Account %q (Resource Group %q) complete", storageAccountName, resourceGroup)
+
+	d.SetId(storageAccountId)
+
+	return resourceArmStorageAccountFailoverRead(d, meta)
+}
+
+func resourceArmStorageAccountFailoverRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Storage.AccountsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	resp, err := client.GetProperties(ctx, resourceGroup, storageAccountName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Storage Account %q (Resource Group %q) was not found - removing from state", storageAccountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	d.Set("storage_account_id", resp.ID)
+
+	if props := resp.AccountProperties; props != nil {
+		lastGeoFailoverTime := ""
+		if props.LastGeoFailoverTime != nil {
+			lastGeoFailoverTime = props.LastGeoFailoverTime.String()
+		}
+		d.Set("last_geo_failover_time", lastGeoFailoverTime)
+	}
+
+	return nil
+}
+
+func resourceArmStorageAccountFailoverDelete(d *schema.ResourceData, meta interface{}) error {
+	// there's no "fail back" operation exposed by the Storage API - this just forgets about the
+	// resource, it doesn't trigger another failover or otherwise modify the Storage Account
+	return nil
+}
@@ -0,0 +1,189 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var storageAccountResourceName = "azurerm_storage_account"
+
+func resourceArmStorageAccountNetworkRules() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageAccountNetworkRulesCreateUpdate,
+		Read:   resourceArmStorageAccountNetworkRulesRead,
+		Update: resourceArmStorageAccountNetworkRulesCreateUpdate,
+		Delete: resourceArmStorageAccountNetworkRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"bypass": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(storage.AzureServices),
+						string(storage.Logging),
+						string(storage.Metrics),
+						string(storage.None),
+					}, true),
+				},
+				Set: schema.HashString,
+			},
+
+			"ip_rules": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"virtual_network_subnet_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"default_action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(storage.DefaultActionAllow),
+					string(storage.DefaultActionDeny),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceArmStorageAccountNetworkRulesCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Storage.AccountsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	storageAccountId := d.Get("storage_account_id").(string)
+
+	id, err := azure.ParseAzureResourceID(storageAccountId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	locks.ByName(storageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(storageAccountName, storageAccountResourceName)
+
+	networkRule := map[string]interface{}{
+		"ip_rules":                   d.Get("ip_rules"),
+		"virtual_network_subnet_ids": d.Get("virtual_network_subnet_ids"),
+		"bypass":                     d.Get("bypass"),
+	}
+	rules := &storage.NetworkRuleSet{
+		IPRules:             expandStorageAccountIPRules(networkRule),
+		VirtualNetworkRules: expandStorageAccountVirtualNetworks(networkRule),
+		Bypass:              expandStorageAccountBypass(networkRule),
+		DefaultAction:       storage.DefaultAction(d.Get("default_action").(string)),
+	}
+
+	opts := storage.AccountUpdateParameters{
+		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+			NetworkRuleSet: rules,
+		},
+	}
+	if _, err := client.Update(ctx, resourceGroup, storageAccountName, opts); err != nil {
+		return fmt.Errorf("Error updating Network Rules for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	d.SetId(storageAccountId)
+
+	return resourceArmStorageAccountNetworkRulesRead(d, meta)
+}
+
+func resourceArmStorageAccountNetworkRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Storage.AccountsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	resp, err := client.GetProperties(ctx, resourceGroup, storageAccountName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Storage Account %q (Resource Group %q) was not found - removing Network Rules from state", storageAccountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	d.Set("storage_account_id", resp.ID)
+
+	if props := resp.AccountProperties; props != nil {
+		if rules := props.NetworkRuleSet; rules != nil {
+			d.Set("bypass", flattenStorageAccountBypass(rules.Bypass))
+			d.Set("default_action", string(rules.DefaultAction))
+			d.Set("ip_rules", flattenStorageAccountIPRules(rules.IPRules))
+			d.Set("virtual_network_subnet_ids", flattenStorageAccountVirtualNetworks(rules.VirtualNetworkRules))
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStorageAccountNetworkRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Storage.AccountsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	locks.ByName(storageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(storageAccountName, storageAccountResourceName)
+
+	log.Printf("[DEBUG] Resetting Network Rules to default for Storage Account %q (Resource Group %q)", storageAccountName, resourceGroup)
+
+	opts := storage.AccountUpdateParameters{
+		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+			NetworkRuleSet: &storage.NetworkRuleSet{
+				DefaultAction: storage.DefaultActionAllow,
+			},
+		},
+	}
+	if _, err := client.Update(ctx, resourceGroup, storageAccountName, opts); err != nil {
+		return fmt.Errorf("Error resetting Network Rules for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	return nil
+}
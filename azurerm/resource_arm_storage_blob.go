@@ -115,8 +115,12 @@ func resourceArmStorageBlob() *schema.Resource {
 				Computed: true,
 			},
 
+			"content_md5": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"parallelism": {
-				// TODO: @tombuildsstuff - a note this only works for Page blobs
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Default:      8,
@@ -312,6 +316,7 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("content_type", props.ContentType)
 	d.Set("type", strings.TrimSuffix(string(props.BlobType), "Blob"))
 	d.Set("url", d.Id())
+	d.Set("content_md5", props.ContentMD5)
 
 	if err := d.Set("metadata", storage.FlattenMetaData(props.MetaData)); err != nil {
 		return fmt.Errorf("Error setting `metadata`: %+v", err)
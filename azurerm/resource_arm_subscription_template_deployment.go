@@ -0,0 +1,187 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSubscriptionTemplateDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubscriptionTemplateDeploymentCreateUpdate,
+		Read:   resourceArmSubscriptionTemplateDeploymentRead,
+		Update: resourceArmSubscriptionTemplateDeploymentCreateUpdate,
+		Delete: resourceArmSubscriptionTemplateDeploymentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"deployment_mode": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(resources.Incremental),
+				}, false),
+			},
+
+			"template_content": {
+				Type:      schema.TypeString,
+				Required:  true,
+				StateFunc: normalizeJson,
+			},
+
+			"parameters_content": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				StateFunc: normalizeJson,
+			},
+
+			"output_content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmSubscriptionTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Resource.DeploymentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.GetAtSubscriptionScope(ctx, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Subscription Template Deployment %q: %+v", name, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_subscription_template_deployment", *existing.ID)
+		}
+	}
+
+	template, err := expandTemplateDeploymentBody(d.Get("template_content").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing `template_content`: %+v", err)
+	}
+
+	properties := resources.DeploymentProperties{
+		Mode:     resources.DeploymentMode(d.Get("deployment_mode").(string)),
+		Template: template,
+	}
+
+	if v, ok := d.GetOk("parameters_content"); ok {
+		parameters, err := expandTemplateDeploymentBody(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing `parameters_content`: %+v", err)
+		}
+		properties.Parameters = parameters
+	}
+
+	deployment := resources.Deployment{
+		Location:   utils.String(d.Get("location").(string)),
+		Properties: &properties,
+	}
+
+	future, err := client.CreateOrUpdateAtSubscriptionScope(ctx, name, deployment)
+	if err != nil {
+		return fmt.Errorf("Error creating Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	read, err := client.GetAtSubscriptionScope(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subscription Template Deployment %q: %+v", name, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Subscription Template Deployment %q ID", name)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSubscriptionTemplateDeploymentRead(d, meta)
+}
+
+func resourceArmSubscriptionTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Resource.DeploymentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["deployments"]
+
+	resp, err := client.GetAtSubscriptionScope(ctx, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	d.Set("name", name)
+	d.Set("location", azure.NormalizeLocation(*resp.Location))
+
+	if props := resp.Properties; props != nil {
+		d.Set("deployment_mode", string(props.Mode))
+
+		outputContent, err := flattenTemplateDeploymentBody(props.Outputs)
+		if err != nil {
+			return fmt.Errorf("Error flattening `output_content`: %+v", err)
+		}
+		d.Set("output_content", outputContent)
+	}
+
+	return nil
+}
+
+func resourceArmSubscriptionTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Resource.DeploymentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["deployments"]
+
+	future, err := client.DeleteAtSubscriptionScope(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	return nil
+}
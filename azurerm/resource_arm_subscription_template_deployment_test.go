@@ -0,0 +1,111 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMSubscriptionTemplateDeployment_basic(t *testing.T) {
+	resourceName := "azurerm_subscription_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubscriptionTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubscriptionTemplateDeployment_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubscriptionTemplateDeploymentExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSubscriptionTemplateDeploymentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).Resource.DeploymentsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetAtSubscriptionScope(ctx, name)
+		if err != nil {
+			return fmt.Errorf("Bad: GetAtSubscriptionScope on deploymentsClient: %s", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Subscription Template Deployment %q does not exist", name)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSubscriptionTemplateDeploymentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Resource.DeploymentsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_subscription_template_deployment" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+
+		resp, err := client.GetAtSubscriptionScope(ctx, name)
+
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Subscription Template Deployment still exists:\n%#v", resp.Properties)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMSubscriptionTemplateDeployment_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_subscription_template_deployment" "test" {
+  name            = "acctestdeployment-%d"
+  location        = "%s"
+  deployment_mode = "Incremental"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-08-01/subscriptionDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.Resources/resourceGroups",
+      "apiVersion": "2019-05-01",
+      "location": "%s",
+      "name": "acctestRG-deployed-%d",
+      "properties": {}
+    }
+  ]
+}
+TEMPLATE
+}
+`, rInt, location, location, rInt)
+}
@@ -0,0 +1,201 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVirtualNetworkDnsServers() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualNetworkDnsServersCreateUpdate,
+		Read:   resourceArmVirtualNetworkDnsServersRead,
+		Update: resourceArmVirtualNetworkDnsServersCreateUpdate,
+		Delete: resourceArmVirtualNetworkDnsServersDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"dns_servers": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmVirtualNetworkDnsServersCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.VnetClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Virtual Network DNS Servers creation.")
+
+	virtualNetworkId := d.Get("virtual_network_id").(string)
+	dnsServers := utils.ExpandStringSlice(d.Get("dns_servers").([]interface{}))
+
+	id, err := azure.ParseAzureResourceID(virtualNetworkId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+
+	locks.ByName(vnetName, virtualNetworkResourceName)
+	defer locks.UnlockByName(vnetName, virtualNetworkResourceName)
+
+	vnet, err := client.Get(ctx, resourceGroup, vnetName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(vnet.Response) {
+			return fmt.Errorf("Virtual Network %q (Resource Group %q) was not found!", vnetName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Network %q (Resource Group %q): %+v", vnetName, resourceGroup, err)
+	}
+
+	props := vnet.VirtualNetworkPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for Virtual Network %q (Resource Group %q)", vnetName, resourceGroup)
+	}
+
+	if d.IsNewResource() {
+		if features.ShouldResourcesBeImported() {
+			if options := props.DhcpOptions; options != nil && options.DNSServers != nil && len(*options.DNSServers) > 0 {
+				return tf.ImportAsExistsError("azurerm_virtual_network_dns_servers", *vnet.ID)
+			}
+		}
+	}
+
+	props.DhcpOptions = &network.DhcpOptions{
+		DNSServers: dnsServers,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, vnetName, vnet)
+	if err != nil {
+		return fmt.Errorf("Error updating DNS Servers for Virtual Network %q (Resource Group %q): %+v", vnetName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of DNS Servers update for Virtual Network %q (Resource Group %q): %+v", vnetName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, vnetName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Network %q (Resource Group %q): %+v", vnetName, resourceGroup, err)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualNetworkDnsServersRead(d, meta)
+}
+
+func resourceArmVirtualNetworkDnsServersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.VnetClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+
+	resp, err := client.Get(ctx, resourceGroup, vnetName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Virtual Network %q (Resource Group %q) could not be found - removing from state!", vnetName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Virtual Network %q (Resource Group %q): %+v", vnetName, resourceGroup, err)
+	}
+
+	props := resp.VirtualNetworkPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for Virtual Network %q (Resource Group %q)", vnetName, resourceGroup)
+	}
+
+	options := props.DhcpOptions
+	if options == nil || options.DNSServers == nil || len(*options.DNSServers) == 0 {
+		log.Printf("[DEBUG] Virtual Network %q (Resource Group %q) has no DNS Servers - removing from state!", vnetName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("virtual_network_id", resp.ID)
+
+	if err := d.Set("dns_servers", utils.FlattenStringSlice(options.DNSServers)); err != nil {
+		return fmt.Errorf("Error setting `dns_servers`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmVirtualNetworkDnsServersDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.VnetClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+
+	locks.ByName(vnetName, virtualNetworkResourceName)
+	defer locks.UnlockByName(vnetName, virtualNetworkResourceName)
+
+	read, err := client.Get(ctx, resourceGroup, vnetName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] Virtual Network %q (Resource Group %q) could not be found - removing from state!", vnetName, resourceGroup)
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Network %q (Resource Group %q): %+v", vnetName, resourceGroup, err)
+	}
+
+	props := read.VirtualNetworkPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for Virtual Network %q (Resource Group %q)", vnetName, resourceGroup)
+	}
+
+	props.DhcpOptions = &network.DhcpOptions{
+		DNSServers: &[]string{},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, vnetName, read)
+	if err != nil {
+		return fmt.Errorf("Error removing DNS Servers from Virtual Network %q (Resource Group %q): %+v", vnetName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of DNS Servers from Virtual Network %q (Resource Group %q): %+v", vnetName, resourceGroup, err)
+	}
+
+	return nil
+}
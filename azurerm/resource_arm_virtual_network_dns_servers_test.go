@@ -0,0 +1,139 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualNetworkDnsServers_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_network_dns_servers.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// intentional as this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMVirtualNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualNetworkDnsServers_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkDnsServersExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dns_servers.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualNetworkDnsServers_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_network_dns_servers.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// intentional as this is a Virtual Resource
+		CheckDestroy: testCheckAzureRMVirtualNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualNetworkDnsServers_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkDnsServersExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualNetworkDnsServers_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_virtual_network_dns_servers"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualNetworkDnsServersExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		vnetId := rs.Primary.Attributes["virtual_network_id"]
+		parsedId, err := azure.ParseAzureResourceID(vnetId)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := parsedId.ResourceGroup
+		vnetName := parsedId.Path["virtualNetworks"]
+
+		client := testAccProvider.Meta().(*ArmClient).Network.VnetClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, vnetName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Virtual Network %q (Resource Group: %q) does not exist", vnetName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vnetClient: %+v", err)
+		}
+
+		props := resp.VirtualNetworkPropertiesFormat
+		if props == nil || props.DhcpOptions == nil || props.DhcpOptions.DNSServers == nil || len(*props.DhcpOptions.DNSServers) == 0 {
+			return fmt.Errorf("No DNS Servers association exists for Virtual Network %q (Resource Group: %q)", vnetName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMVirtualNetworkDnsServers_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_virtual_network_dns_servers" "test" {
+  virtual_network_id = "${azurerm_virtual_network.test.id}"
+  dns_servers        = ["8.8.8.8", "8.8.4.4"]
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMVirtualNetworkDnsServers_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVirtualNetworkDnsServers_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network_dns_servers" "import" {
+  virtual_network_id = "${azurerm_virtual_network_dns_servers.test.virtual_network_id}"
+  dns_servers        = ["${azurerm_virtual_network_dns_servers.test.dns_servers}"]
+}
+`, template)
+}
@@ -225,6 +225,24 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 								}, true),
 							},
 						},
+
+						"aad_tenant": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"aad_audience": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"aad_issuer": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
 					},
 				},
 			},
@@ -563,7 +581,7 @@ func expandArmVirtualNetworkGatewayVpnClientConfig(d *schema.ResourceData) *netw
 	confRadiusServerAddress := conf["radius_server_address"].(string)
 	confRadiusServerSecret := conf["radius_server_secret"].(string)
 
-	return &network.VpnClientConfiguration{
+	vpnClientConfig := network.VpnClientConfiguration{
 		VpnClientAddressPool: &network.AddressSpace{
 			AddressPrefixes: &addresses,
 		},
@@ -573,6 +591,20 @@ func expandArmVirtualNetworkGatewayVpnClientConfig(d *schema.ResourceData) *netw
 		RadiusServerAddress:          &confRadiusServerAddress,
 		RadiusServerSecret:           &confRadiusServerSecret,
 	}
+
+	if v, ok := conf["aad_tenant"]; ok && v.(string) != "" {
+		vpnClientConfig.AadTenant = utils.String(v.(string))
+	}
+
+	if v, ok := conf["aad_audience"]; ok && v.(string) != "" {
+		vpnClientConfig.AadAudience = utils.String(v.(string))
+	}
+
+	if v, ok := conf["aad_issuer"]; ok && v.(string) != "" {
+		vpnClientConfig.AadIssuer = utils.String(v.(string))
+	}
+
+	return &vpnClientConfig
 }
 
 func expandArmVirtualNetworkGatewaySku(d *schema.ResourceData) *network.VirtualNetworkGatewaySku {
@@ -690,6 +722,18 @@ func flattenArmVirtualNetworkGatewayVpnClientConfig(cfg *network.VpnClientConfig
 		flat["radius_server_secret"] = *v
 	}
 
+	if v := cfg.AadTenant; v != nil {
+		flat["aad_tenant"] = *v
+	}
+
+	if v := cfg.AadAudience; v != nil {
+		flat["aad_audience"] = *v
+	}
+
+	if v := cfg.AadIssuer; v != nil {
+		flat["aad_issuer"] = *v
+	}
+
 	return []interface{}{flat}
 }
 
@@ -75,6 +75,14 @@ func resourceArmVirtualNetworkPeering() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"remote_address_space": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -163,6 +171,12 @@ func resourceArmVirtualNetworkPeeringRead(d *schema.ResourceData, meta interface
 		if network := peer.RemoteVirtualNetwork; network != nil {
 			d.Set("remote_virtual_network_id", network.ID)
 		}
+
+		remoteAddressSpace := make([]string, 0)
+		if addressSpace := peer.RemoteAddressSpace; addressSpace != nil && addressSpace.AddressPrefixes != nil {
+			remoteAddressSpace = *addressSpace.AddressPrefixes
+		}
+		d.Set("remote_address_space", remoteAddressSpace)
 	}
 
 	return nil
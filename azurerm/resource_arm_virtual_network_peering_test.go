@@ -121,6 +121,8 @@ func TestAccAzureRMVirtualNetworkPeering_update(t *testing.T) {
 					resource.TestCheckResourceAttr(secondResourceName, "allow_virtual_network_access", "true"),
 					resource.TestCheckResourceAttr(firstResourceName, "allow_forwarded_traffic", "false"),
 					resource.TestCheckResourceAttr(secondResourceName, "allow_forwarded_traffic", "false"),
+					resource.TestCheckResourceAttrSet(firstResourceName, "remote_address_space.0"),
+					resource.TestCheckResourceAttrSet(secondResourceName, "remote_address_space.0"),
 				),
 			},
 